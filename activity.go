@@ -0,0 +1,68 @@
+package tracker
+
+import (
+	"sort"
+	"time"
+)
+
+// Types of activity events.
+const (
+	ActivityComment   = "comment"
+	ActivityChangelog = "changelog"
+	ActivityWorklog   = "worklog"
+)
+
+// ActivityEvent is an entry of the issue timeline. Exactly one of Comment, Change and Worklog is set, matching Type.
+type ActivityEvent struct {
+	// Event type: ActivityComment, ActivityChangelog or ActivityWorklog.
+	Type string
+
+	// Time of the event.
+	At time.Time
+
+	// User who caused the event.
+	Author *BasicUser
+
+	Comment *Comment
+	Change  *ChangelogEntry
+	Worklog *Worklog
+}
+
+// GetActivity
+// Get the issue comments, changelog and worklog merged into one chronological timeline
+func (t *TrackerClient) GetActivity(issueKey string) ([]*ActivityEvent, error) {
+	comments, err := t.GetAllComments(issueKey)
+	if err != nil {
+		return nil, err
+	}
+	changes, err := t.GetAllChangelog(issueKey, nil)
+	if err != nil {
+		return nil, err
+	}
+	worklogs, _, err := t.GetWorklogs(issueKey)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*ActivityEvent, 0, len(comments)+len(changes)+len(worklogs))
+	for _, comment := range comments {
+		events = append(events, &ActivityEvent{
+			Type: ActivityComment, At: comment.CreatedAt.Time, Author: comment.CreatedBy, Comment: comment,
+		})
+	}
+	for _, change := range changes {
+		events = append(events, &ActivityEvent{
+			Type: ActivityChangelog, At: change.UpdatedAt.Time, Author: change.UpdatedBy, Change: change,
+		})
+	}
+	for _, worklog := range worklogs {
+		events = append(events, &ActivityEvent{
+			Type: ActivityWorklog, At: worklog.CreatedAt.Time, Author: worklog.CreatedBy, Worklog: worklog,
+		})
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].At.Before(events[j].At)
+	})
+
+	return events, nil
+}
@@ -0,0 +1,149 @@
+package tracker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// TagCount is the number of issues having a tag.
+type TagCount struct {
+	// Tag.
+	Tag string
+
+	// Number of issues with the tag.
+	Count int
+}
+
+// AggregateTags
+// Count the issues matching the search by tag, scrolling through all of them.
+// Tags are sorted by the number of issues, most used first, and then by name.
+func (t *TrackerClient) AggregateTags(opts *FindIssuesOptions) ([]*TagCount, error) {
+	counts := make(map[string]int)
+	err := t.FindIssuesScroll(opts, &ScrollOptions{Type: ScrollTypeUnsorted, PerScroll: scrollMaxPerScroll},
+		func(issues []*Issue) error {
+			for _, issue := range issues {
+				for _, tag := range issue.Tags {
+					counts[tag]++
+				}
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*TagCount, 0, len(counts))
+	for tag, count := range counts {
+		result = append(result, &TagCount{Tag: tag, Count: count})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		return result[i].Tag < result[j].Tag
+	})
+
+	return result, nil
+}
+
+// SumSpentTime
+// Sum the time spent on all issues matching the search, scrolling through them.
+// If progress is not nil it is called after every scroll page with the number of issues counted so far and their sum.
+func (t *TrackerClient) SumSpentTime(
+	opts *FindIssuesOptions, progress func(issues int, spent time.Duration),
+) (time.Duration, error) {
+	var (
+		count int
+		total time.Duration
+	)
+	err := t.FindIssuesScroll(opts, &ScrollOptions{Type: ScrollTypeUnsorted, PerScroll: scrollMaxPerScroll},
+		func(issues []*Issue) error {
+			for _, issue := range issues {
+				if issue.Spent != nil {
+					total += issue.Spent.Duration
+				}
+			}
+			count += len(issues)
+			if progress != nil {
+				progress(count, total)
+			}
+			return nil
+		})
+	if err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// CountByQueue
+// Count the issues of every queue matching extraQuery, e.g. Resolution: empty(), or all of them if it is empty.
+// Queues are counted concurrently through the client's rate limiter; if any count failed, the counts that
+// succeeded are returned together with a *BatchError.
+func (t *TrackerClient) CountByQueue(queueKeys []string, extraQuery string) (map[string]int, error) {
+	var mu sync.Mutex
+	result := make(map[string]int, len(queueKeys))
+	err := t.BatchExecute(context.Background(), queueKeys, func(ctx context.Context, queueKey string) error {
+		query := fmt.Sprintf(`Queue: %s`, quoteQueryValue(t.CanonicalQueueKey(queueKey)))
+		if extraQuery != "" {
+			query += " AND (" + extraQuery + ")"
+		}
+		count, _, err := t.CountIssues(&FindIssuesOptions{Query: &query})
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		result[queueKey] = count
+		mu.Unlock()
+		return nil
+	})
+
+	return result, err
+}
+
+// Query matching the issues counted as open by QueueSummaries.
+const openIssuesQuery = "Resolution: empty()"
+
+// QueueSummary is a queue with the number of its open issues.
+type QueueSummary struct {
+	// Queue key.
+	Key string
+
+	// Queue name.
+	Name string
+
+	// Object with information about the queue owner.
+	Lead *BasicUser
+
+	// Number of issues of the queue without a resolution.
+	OpenCount int
+}
+
+// QueueSummaries
+// Get every queue readable by the current user with the number of its unresolved issues, counted concurrently.
+// If some counts failed, the queues counted are returned together with a *BatchError naming the others.
+func (t *TrackerClient) QueueSummaries() ([]*QueueSummary, error) {
+	queues, err := t.GetMyQueues()
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, len(queues))
+	for i, queue := range queues {
+		keys[i] = queue.Key
+	}
+
+	counts, err := t.CountByQueue(keys, openIssuesQuery)
+	result := make([]*QueueSummary, 0, len(queues))
+	for _, queue := range queues {
+		count, ok := counts[queue.Key]
+		if !ok {
+			continue
+		}
+		result = append(result, &QueueSummary{Key: queue.Key, Name: queue.Name, Lead: queue.Lead, OpenCount: count})
+	}
+
+	return result, err
+}
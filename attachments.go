@@ -1 +1,259 @@
 package tracker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Attachment structure in Yandex.Tracker
+// https://cloud.yandex.ru/en/docs/tracker/concepts/issues/get-attachments-list
+type Attachment struct {
+	// Address of the API resource with information about the attachment.
+	Self string `json:"self"`
+
+	// Attachment ID.
+	ID string `json:"id"`
+
+	// File name.
+	Name string `json:"name"`
+
+	// Address of the resource to download the file from.
+	Content string `json:"content"`
+
+	// Address of the resource to download the preview thumbnail from. Available for image files only.
+	Thumbnail string `json:"thumbnail"`
+
+	// Object with information about the user who attached the file.
+	CreatedBy *BasicUser `json:"createdBy"`
+
+	// Date and time when the file was uploaded.
+	CreatedAt TrackerTime `json:"createdAt"`
+
+	// File type, for example text/plain or image/png.
+	Mimetype string `json:"mimetype"`
+
+	// File size in bytes.
+	Size int64 `json:"size"`
+
+	// Object with metadata of the file.
+	Metadata *AttachmentMetadata `json:"metadata"`
+}
+
+type AttachmentMetadata struct {
+	// Image size in pixels, for example 640x480.
+	Size string `json:"size"`
+}
+
+func (t *TrackerClient) GetAttachments(issueKey string) ([]*Attachment, *resty.Response, error) {
+	req := t.NewRequest(resty.MethodGet, "/v2/issues/"+issueKey+"/attachments", nil)
+	var result []*Attachment
+	resp, err := t.Do(req, &result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+func (t *TrackerClient) GetAttachment(issueKey, attachmentID string) (*Attachment, *resty.Response, error) {
+	req := t.NewRequest(resty.MethodGet, "/v2/issues/"+issueKey+"/attachments/"+attachmentID, nil)
+	result := new(Attachment)
+	resp, err := t.Do(req, result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+// UploadTemporaryAttachment
+// Upload a file that is not attached to any issue yet. Pass the returned ID in the AttachmentIDs of
+// CreateIssueOptions or AddCommentOptions to attach it. Temporary files that are never attached are removed by Tracker.
+// https://cloud.yandex.ru/en/docs/tracker/concepts/issues/temp-attachment
+func (t *TrackerClient) UploadTemporaryAttachment(fileName string, r io.Reader) (*Attachment, *resty.Response, error) {
+	req := t.NewRequest(resty.MethodPost, "/v2/attachments/", nil).
+		SetFileReader("file", fileName, r).
+		SetQueryParam("filename", fileName)
+	result := new(Attachment)
+	resp, err := t.Do(req, result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+// AttachFile
+// Attach a file to the issue. Its type is detected from the content
+// https://cloud.yandex.ru/en/docs/tracker/concepts/issues/post-attachment
+func (t *TrackerClient) AttachFile(issueKey, fileName string, r io.Reader) (*Attachment, *resty.Response, error) {
+	return t.AttachFileWithType(issueKey, fileName, "", r)
+}
+
+// AttachFileWithType
+// Attach a file to the issue with the MIME type contentType, e.g. image/png, which Tracker stores as the attachment type.
+// An empty contentType detects the type from the content
+func (t *TrackerClient) AttachFileWithType(
+	issueKey, fileName, contentType string, r io.Reader,
+) (*Attachment, *resty.Response, error) {
+	req := t.NewRequest(resty.MethodPost, "/v2/issues/"+issueKey+"/attachments/", nil).
+		SetQueryParam("filename", fileName)
+	if contentType == "" {
+		req.SetFileReader("file", fileName, r)
+	} else {
+		req.SetMultipartField("file", fileName, contentType, r)
+	}
+	result := new(Attachment)
+	resp, err := t.Do(req, result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+// DownloadAttachment
+// Write the content of the attachment to w, returning the number of bytes written.
+// The content address returned by the API is followed as is; the authorization headers are only sent
+// when it points to the Tracker API, so a content host such as a CDN never receives the token.
+func (t *TrackerClient) DownloadAttachment(ctx context.Context, attachment *Attachment, w io.Writer) (int64, error) {
+	contentURL := attachment.Content
+	if strings.HasPrefix(contentURL, "/") {
+		contentURL = baseUrl + contentURL
+	}
+	if err := t.limiter.wait(ctx); err != nil {
+		return 0, fmt.Errorf("rate limit: %w", err)
+	}
+
+	var body io.ReadCloser
+	if isAPIURL(contentURL) {
+		resp, err := t.client.R().
+			SetContext(ctx).
+			SetHeaders(t.headers).
+			SetDoNotParseResponse(true).
+			Get(contentURL)
+		if err != nil {
+			return 0, fmt.Errorf("request: %w", err)
+		}
+		body = resp.RawBody()
+		if resp.IsError() {
+			defer closeBody(body)
+			message, _ := io.ReadAll(io.LimitReader(body, drainLimit))
+			return 0, t.newAPIError(resp.StatusCode(), resp.Header(), message)
+		}
+	} else {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, contentURL, nil)
+		if err != nil {
+			return 0, fmt.Errorf("new request: %w", err)
+		}
+		resp, err := t.client.GetClient().Do(req)
+		if err != nil {
+			return 0, fmt.Errorf("request: %w", err)
+		}
+		body = resp.Body
+		if resp.StatusCode >= http.StatusBadRequest {
+			defer closeBody(body)
+			message, _ := io.ReadAll(io.LimitReader(body, drainLimit))
+			return 0, t.newAPIError(resp.StatusCode, resp.Header, message)
+		}
+	}
+	defer closeBody(body)
+
+	n, err := io.Copy(w, body)
+	if err != nil {
+		return n, fmt.Errorf("copy: %w", err)
+	}
+
+	return n, nil
+}
+
+// DownloadAllAttachments
+// Save every file attached to the issue into destDir, returning the number of files saved.
+// File names are stripped of directories; existing files are kept and a name already taken gets a numeric suffix,
+// e.g. report_1.pdf.
+func (t *TrackerClient) DownloadAllAttachments(issueKey, destDir string) (int, error) {
+	attachments, _, err := t.GetAttachments(issueKey)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, attachment := range attachments {
+		if err := t.downloadAttachmentTo(attachment, destDir); err != nil {
+			return i, fmt.Errorf("attachment %s: %w", attachment.ID, err)
+		}
+	}
+
+	return len(attachments), nil
+}
+
+func (t *TrackerClient) downloadAttachmentTo(attachment *Attachment, destDir string) error {
+	file, err := createUnique(destDir, sanitizeFileName(attachment.Name))
+	if err != nil {
+		return err
+	}
+
+	_, err = t.DownloadAttachment(context.Background(), attachment, file)
+	if closeErr := file.Close(); err == nil && closeErr != nil {
+		err = fmt.Errorf("close: %w", closeErr)
+	}
+	if err != nil {
+		_ = os.Remove(file.Name())
+	}
+
+	return err
+}
+
+// sanitizeFileName
+// Keep only the last element of the name so that it cannot point outside the destination directory
+func sanitizeFileName(name string) string {
+	name = filepath.Base(strings.ReplaceAll(name, "\\", "/"))
+	if name == "." || name == ".." || name == "/" || name == "" {
+		return "attachment"
+	}
+
+	return name
+}
+
+// createUnique
+// Create a new file named name in dir, adding a numeric suffix while the name is taken
+func createUnique(dir, name string) (*os.File, error) {
+	ext := filepath.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+	for i := 0; ; i++ {
+		candidate := name
+		if i > 0 {
+			candidate = base + "_" + strconv.Itoa(i) + ext
+		}
+		file, err := os.OpenFile(filepath.Join(dir, candidate), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if errors.Is(err, fs.ErrExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("create: %w", err)
+		}
+		return file, nil
+	}
+}
+
+// isAPIURL
+// Report whether the address points to the Tracker API host
+func isAPIURL(rawURL string) bool {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+	api, _ := url.Parse(baseUrl)
+
+	return parsed.Scheme == api.Scheme && strings.EqualFold(parsed.Host, api.Host)
+}
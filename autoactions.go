@@ -0,0 +1,94 @@
+package tracker
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Autoaction structure in Yandex.Tracker
+// https://cloud.yandex.ru/en/docs/tracker/concepts/queues/get-autoaction
+type Autoaction struct {
+	// Address of the API resource with information about the autoaction.
+	Self string `json:"self"`
+
+	// Autoaction ID.
+	ID string `json:"id"`
+
+	// Object with information about the queue.
+	Queue *BasicQueue `json:"queue"`
+
+	// Autoaction name.
+	Name string `json:"name"`
+
+	// Autoaction version. Each change to the autoaction increases its version number.
+	Version int `json:"version"`
+
+	// Flag indicating that the autoaction is enabled.
+	Active bool `json:"active"`
+
+	// Issue filtering parameters selecting the issues the autoaction applies to.
+	Filter map[string]interface{} `json:"filter"`
+
+	// Filter in the query language, set instead of Filter.
+	Query string `json:"query"`
+
+	// Array of actions applied to the issues.
+	Actions []json.RawMessage `json:"actions"`
+
+	// Interval between runs in milliseconds.
+	IntervalMillis int64 `json:"intervalMillis"`
+
+	// Object with information about the working calendar the runs follow.
+	Calendar *AutoactionCalendar `json:"calendar"`
+
+	// Flag indicating that notifications are sent about the changes the autoaction makes.
+	EnableNotifications bool `json:"enableNotifications"`
+
+	// Number of issues the autoaction has processed.
+	TotalIssuesProcessed int `json:"totalIssuesProcessed"`
+
+	// Date and time when the autoaction was created.
+	CreatedAt TrackerTime `json:"created"`
+
+	// Date and time when the autoaction was last updated.
+	UpdatedAt TrackerTime `json:"updated"`
+}
+
+type AutoactionCalendar struct {
+	// Calendar ID.
+	ID int64 `json:"id"`
+}
+
+// Interval
+// Get the time between runs of the autoaction
+func (a *Autoaction) Interval() time.Duration {
+	return time.Duration(a.IntervalMillis) * time.Millisecond
+}
+
+// GetAutoactions
+// Get the autoactions of the queue. The API has no way to run an autoaction on demand:
+// it runs on its own schedule while it is active.
+func (t *TrackerClient) GetAutoactions(queueKey string) ([]*Autoaction, *resty.Response, error) {
+	req := t.NewRequest(resty.MethodGet, "/v2/queues/"+t.CanonicalQueueKey(queueKey)+"/autoactions", nil)
+	var result []*Autoaction
+	resp, err := t.Do(req, &result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+func (t *TrackerClient) GetAutoaction(queueKey, autoactionID string) (*Autoaction, *resty.Response, error) {
+	req := t.NewRequest(resty.MethodGet, "/v2/queues/"+t.CanonicalQueueKey(queueKey)+"/autoactions/"+autoactionID, nil)
+	result := new(Autoaction)
+	resp, err := t.Do(req, result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
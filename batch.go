@@ -0,0 +1,158 @@
+package tracker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Number of keys processed in parallel by batch helpers unless set with WithConcurrency.
+const defaultConcurrency = 4
+
+// BatchError is returned by BatchExecute when fn failed for some of the keys.
+type BatchError struct {
+	// Errors keyed by the key fn failed for.
+	Errors map[string]error
+}
+
+func (e *BatchError) Error() string {
+	keys := make([]string, 0, len(e.Errors))
+	for key := range e.Errors {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	messages := make([]string, len(keys))
+	for i, key := range keys {
+		messages[i] = fmt.Sprintf("%s: %v", key, e.Errors[key])
+	}
+
+	return fmt.Sprintf("%d of batch failed: %s", len(keys), strings.Join(messages, "; "))
+}
+
+func (e *BatchError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+// WithConcurrency
+// Set the number of keys processed in parallel by BatchExecute and the helpers built on it
+func (t *TrackerClient) WithConcurrency(n int) {
+	t.concurrency = n
+}
+
+// BatchExecute
+// Run fn for every key with bounded concurrency. Requests made by fn go through
+// the client's rate limiter. Keys not started before ctx is done fail with ctx.Err().
+// Returns a *BatchError if fn failed for any key.
+func (t *TrackerClient) BatchExecute(
+	ctx context.Context, keys []string, fn func(ctx context.Context, key string) error,
+) error {
+	concurrency := t.concurrency
+	if concurrency <= 0 {
+		concurrency = defaultConcurrency
+	}
+
+	var (
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+		errs = make(map[string]error)
+		sem  = make(chan struct{}, concurrency)
+	)
+	for _, key := range keys {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if err := ctx.Err(); err != nil {
+			mu.Lock()
+			errs[key] = err
+			mu.Unlock()
+			continue
+		}
+
+		wg.Add(1)
+		go func(key string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := fn(ctx, key); err != nil {
+				mu.Lock()
+				errs[key] = err
+				mu.Unlock()
+			}
+		}(key)
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return &BatchError{Errors: errs}
+	}
+
+	return nil
+}
+
+// BulkAddComment
+// Add a comment with text to every issue concurrently, as Tracker has no bulk comment operation.
+// The result holds an entry for every key, nil where the comment was added.
+// If any comment failed, the failures are also returned as a *BatchError.
+func (t *TrackerClient) BulkAddComment(keys []string, text string) (map[string]error, error) {
+	err := t.BatchExecute(context.Background(), keys, func(ctx context.Context, key string) error {
+		_, _, err := t.AddComment(key, &AddCommentOptions{Text: &text})
+		return err
+	})
+
+	result := make(map[string]error, len(keys))
+	for _, key := range keys {
+		result[key] = nil
+	}
+	var batchErr *BatchError
+	if errors.As(err, &batchErr) {
+		for key, keyErr := range batchErr.Errors {
+			result[key] = keyErr
+		}
+	}
+
+	return result, err
+}
+
+// ReassignAll
+// Assign every issue assigned to fromLogin to toLogin, only in the queue with queueKey if it is not empty.
+// All matching issues are found before any is changed, then reassigned concurrently.
+// Returns the number of issues reassigned; issues that failed are reported in a *BatchError.
+func (t *TrackerClient) ReassignAll(fromLogin, toLogin, queueKey string) (int, error) {
+	if fromLogin == "" || toLogin == "" {
+		return 0, &ValidationError{Field: "login", Reason: "both logins are required to reassign issues"}
+	}
+	filter := map[string]interface{}{"assignee": fromLogin}
+	if queueKey != "" {
+		filter["queue"] = t.CanonicalQueueKey(queueKey)
+	}
+	issues, err := t.FindAllIssues(&FindIssuesOptions{Filter: filter})
+	if err != nil {
+		return 0, err
+	}
+	keys := make([]string, len(issues))
+	for i, issue := range issues {
+		keys[i] = issue.Key
+	}
+
+	err = t.BatchExecute(context.Background(), keys, func(ctx context.Context, key string) error {
+		_, _, err := t.UpdateIssue(key, &UpdateIssueOptions{Assignee: &toLogin})
+		return err
+	})
+	var batchErr *BatchError
+	if errors.As(err, &batchErr) {
+		return len(keys) - len(batchErr.Errors), err
+	}
+
+	return len(keys), err
+}
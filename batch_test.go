@@ -0,0 +1,55 @@
+package tracker
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestBulkAddComment(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		texts = make(map[string]string)
+	)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/v2/issues/"), "/comments")
+		if r.Method != http.MethodPost || !strings.HasSuffix(r.URL.Path, "/comments") {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		if key == "TEST-404" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errorMessages":["Issue does not exist."]}`))
+			return
+		}
+		var body struct {
+			Text string `json:"text"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		mu.Lock()
+		texts[key] = body.Text
+		mu.Unlock()
+		_, _ = w.Write([]byte(`{"id":1,"text":"` + body.Text + `"}`))
+	})
+
+	keys := []string{"TEST-1", "TEST-404", "TEST-2"}
+	result, err := client.BulkAddComment(keys, "notice")
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("err = %v, want BatchError", err)
+	}
+	if len(result) != len(keys) {
+		t.Errorf("result = %v, want an entry for every key", result)
+	}
+	if result["TEST-1"] != nil || result["TEST-2"] != nil {
+		t.Errorf("result = %v, want no errors for existing issues", result)
+	}
+	if !errors.Is(result["TEST-404"], ErrNotFound) {
+		t.Errorf("TEST-404 err = %v, want ErrNotFound", result["TEST-404"])
+	}
+	if len(texts) != 2 || texts["TEST-1"] != "notice" || texts["TEST-2"] != "notice" {
+		t.Errorf("comments = %v", texts)
+	}
+}
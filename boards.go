@@ -0,0 +1,134 @@
+package tracker
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type BasicBoard struct {
+	// Address of the API resource with information about the board.
+	Self string `json:"self"`
+
+	// Board ID.
+	ID string `json:"id"`
+
+	// Board name displayed.
+	Display string `json:"display"`
+}
+
+// BoardRef is a board listed in Issue.Boards.
+type BoardRef struct {
+	// Board ID.
+	ID int64 `json:"id"`
+}
+
+// Board structure in Yandex.Tracker
+// https://cloud.yandex.ru/en/docs/tracker/concepts/boards/get-board
+type Board struct {
+	// Address of the API resource with information about the board.
+	Self string `json:"self"`
+
+	// Board ID.
+	ID int64 `json:"id"`
+
+	// Board version.
+	Version int `json:"version"`
+
+	// Board name.
+	Name string `json:"name"`
+}
+
+func (t *TrackerClient) GetBoard(boardID string) (*Board, *resty.Response, error) {
+	req := t.NewRequest(resty.MethodGet, "/v2/boards/"+boardID, nil)
+	result := new(Board)
+	resp, err := t.Do(req, result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+// GetIssueBoards
+// Get the boards the issue is on, fetching every board listed in Issue.Boards for its name
+func (t *TrackerClient) GetIssueBoards(issueKey string) ([]*Board, error) {
+	issue, _, err := t.GetIssue(issueKey)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*Board, 0, len(issue.Boards))
+	for _, ref := range issue.Boards {
+		board, _, err := t.GetBoard(strconv.FormatInt(ref.ID, 10))
+		if err != nil {
+			return nil, fmt.Errorf("board %d: %w", ref.ID, err)
+		}
+		result = append(result, board)
+	}
+
+	return result, nil
+}
+
+// BoardColumn structure in Yandex.Tracker
+// https://cloud.yandex.ru/en/docs/tracker/concepts/boards/get-columns
+type BoardColumn struct {
+	// Address of the API resource with information about the column.
+	Self string `json:"self"`
+
+	// Column ID.
+	ID int64 `json:"id"`
+
+	// Column name.
+	Name string `json:"name"`
+
+	// Array of objects with information about the statuses of issues in the column.
+	Statuses []*BasicStatus `json:"statuses"`
+}
+
+func (t *TrackerClient) GetBoardColumns(boardID string) ([]*BoardColumn, *resty.Response, error) {
+	req := t.NewRequest(resty.MethodGet, "/v2/boards/"+boardID+"/columns", nil)
+	var result []*BoardColumn
+	resp, err := t.Do(req, &result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+// GroupSprintIssuesByStatus
+// Get the sprint issues grouped by the name of the board column their status belongs to.
+// Issues whose status has no column on the board are grouped by the status name.
+func (t *TrackerClient) GroupSprintIssuesByStatus(boardID, sprintID string) (map[string][]*Issue, error) {
+	columns, _, err := t.GetBoardColumns(boardID)
+	if err != nil {
+		return nil, err
+	}
+	columnByStatus := make(map[string]string)
+	for _, column := range columns {
+		for _, status := range column.Statuses {
+			columnByStatus[status.Key] = column.Name
+		}
+	}
+
+	issues, err := t.FindAllIssues(&FindIssuesOptions{Filter: map[string]interface{}{"sprint": sprintID}})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string][]*Issue)
+	for _, issue := range issues {
+		if issue.Status == nil {
+			continue
+		}
+		group, ok := columnByStatus[issue.Status.Key]
+		if !ok {
+			group = issue.Status.Display
+		}
+		result[group] = append(result[group], issue)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,122 @@
+package tracker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// BulkOperation structure in Yandex.Tracker
+// https://cloud.yandex.ru/en/docs/tracker/concepts/bulkchange/bulkmove-issues
+type BulkOperation struct {
+	// Address of the API resource with information about the bulk operation.
+	Self string `json:"self"`
+
+	// Bulk operation ID.
+	ID string `json:"id"`
+
+	// Object with information about the user who started the bulk operation.
+	CreatedBy *BasicUser `json:"createdBy"`
+
+	// Bulk operation creation date and time.
+	CreatedAt TrackerTime `json:"createdAt"`
+
+	// Bulk operation status, for example CREATED, RUNNING, COMPLETE or FAILED.
+	Status string `json:"status"`
+
+	// Description of the bulk operation status.
+	StatusText string `json:"statusText"`
+
+	// Percentage of completed data chunks.
+	ExecutionChunkPercent int `json:"executionChunkPercent"`
+
+	// Percentage of processed issues.
+	ExecutionIssuePercent int `json:"executionIssuePercent"`
+}
+
+// Statuses of a finished bulk operation.
+const (
+	BulkStatusComplete = "COMPLETE"
+	BulkStatusFailed   = "FAILED"
+)
+
+// Done
+// Report whether the bulk operation reached a terminal status
+func (b *BulkOperation) Done() bool {
+	return b.Status == BulkStatusComplete || b.Status == BulkStatusFailed
+}
+
+// https://cloud.yandex.ru/en/docs/tracker/concepts/bulkchange/bulkmove-issues
+type BulkMoveOptions struct {
+	// Key of the queue to move the issues to. Required.
+	Queue *string `json:"queue,omitempty"`
+
+	// Keys of the issues to move. Required.
+	Issues *[]string `json:"issues,omitempty"`
+
+	// Issue field values to set when moving.
+	Values map[string]interface{} `json:"values,omitempty"`
+
+	// Flag indicating whether to move the issue versions, components and projects to the new queue.
+	MoveAllFields *bool `json:"moveAllFields,omitempty"`
+
+	// Flag indicating whether to reset the issue statuses to the initial status of the new queue.
+	InitialStatus *bool `json:"initialStatus,omitempty"`
+}
+
+func (t *TrackerClient) BulkMove(opts *BulkMoveOptions) (*BulkOperation, *resty.Response, error) {
+	req := t.NewRequest(resty.MethodPost, "/v2/bulkchange/_move", opts)
+	result := new(BulkOperation)
+	resp, err := t.Do(req, result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+func (t *TrackerClient) BulkGetStatus(bulkID string) (*BulkOperation, *resty.Response, error) {
+	return t.bulkGetStatus(context.Background(), bulkID)
+}
+
+// WaitForBulk
+// Poll the bulk operation every pollInterval until it is done or ctx is cancelled.
+// A failed operation is returned together with an error carrying its status text.
+func (t *TrackerClient) WaitForBulk(
+	ctx context.Context, bulkID string, pollInterval time.Duration,
+) (*BulkOperation, error) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		operation, _, err := t.bulkGetStatus(ctx, bulkID)
+		if err != nil {
+			return nil, err
+		}
+		if operation.Done() {
+			if operation.Status == BulkStatusFailed {
+				return operation, fmt.Errorf("bulk operation %s failed: %s", bulkID, operation.StatusText)
+			}
+			return operation, nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return operation, ctx.Err()
+		}
+	}
+}
+
+func (t *TrackerClient) bulkGetStatus(ctx context.Context, bulkID string) (*BulkOperation, *resty.Response, error) {
+	req := t.NewRequest(resty.MethodGet, "/v2/bulkchange/"+bulkID, nil).SetContext(ctx)
+	result := new(BulkOperation)
+	resp, err := t.Do(req, result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
@@ -0,0 +1,226 @@
+package tracker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Version of the bundle format written by ExportIssueBundle.
+const issueBundleVersion = 1
+
+// Issue fields restored by ImportIssueBundle, in addition to the queue.
+var issueBundleFields = []string{
+	"summary", "description", "type", "priority", "status", "resolution", "assignee", "followers", "tags",
+	"deadline", "start", "end", "createdAt", "createdBy", "updatedAt", "updatedBy", "resolvedAt", "resolvedBy",
+}
+
+// IssueBundle is an issue together with everything attached to it, as written by ExportIssueBundle.
+type IssueBundle struct {
+	// Version of the bundle format.
+	Version int `json:"version"`
+
+	// Issue as returned by the API, with all of its fields.
+	Issue json.RawMessage `json:"issue"`
+
+	// All comments of the issue.
+	Comments []*Comment `json:"comments"`
+
+	// Files attached to the issue.
+	Attachments []*BundleAttachment `json:"attachments"`
+
+	// Links to other issues.
+	Links []*IssueLink `json:"links"`
+
+	// Time logged on the issue.
+	Worklogs []*Worklog `json:"worklogs"`
+
+	// History of the issue changes.
+	Changelog []*ChangelogEntry `json:"changelog"`
+}
+
+// BundleAttachment is an attachment in an IssueBundle.
+type BundleAttachment struct {
+	*Attachment
+
+	// File content, encoded in base64 in JSON. Null if the bundle was exported without content,
+	// so that an exported empty file is told apart from a missing one.
+	Data []byte `json:"data"`
+}
+
+// ExportIssueBundle
+// Write the issue, its comments, attachments, links, worklogs and changelog to w as a single JSON document.
+// With withContent the attachment files are downloaded and embedded in base64, otherwise only their metadata is written.
+// Nothing is written if any of the requests fails, so the bundle is never incomplete
+func (t *TrackerClient) ExportIssueBundle(issueKey string, w io.Writer, withContent bool) error {
+	bundle := &IssueBundle{Version: issueBundleVersion}
+
+	var err error
+	if bundle.Issue, _, err = t.GetIssueRaw(issueKey); err != nil {
+		return fmt.Errorf("issue: %w", err)
+	}
+	if bundle.Comments, err = t.GetAllComments(issueKey); err != nil {
+		return fmt.Errorf("comments: %w", err)
+	}
+	attachments, _, err := t.GetAttachments(issueKey)
+	if err != nil {
+		return fmt.Errorf("attachments: %w", err)
+	}
+	if bundle.Links, _, err = t.GetLinks(issueKey); err != nil {
+		return fmt.Errorf("links: %w", err)
+	}
+	if bundle.Worklogs, _, err = t.GetWorklogs(issueKey); err != nil {
+		return fmt.Errorf("worklogs: %w", err)
+	}
+	if bundle.Changelog, err = t.GetAllChangelog(issueKey, nil); err != nil {
+		return fmt.Errorf("changelog: %w", err)
+	}
+
+	bundle.Attachments = make([]*BundleAttachment, len(attachments))
+	for i, attachment := range attachments {
+		bundle.Attachments[i] = &BundleAttachment{Attachment: attachment}
+		if !withContent {
+			continue
+		}
+		var content bytes.Buffer
+		if _, err := t.DownloadAttachment(context.Background(), attachment, &content); err != nil {
+			return fmt.Errorf("attachment %s: %w", attachment.Name, err)
+		}
+		// Bytes of an empty buffer is nil, which would be taken for an attachment exported without content.
+		bundle.Attachments[i].Data = append([]byte{}, content.Bytes()...)
+	}
+
+	if err := json.NewEncoder(w).Encode(bundle); err != nil {
+		return fmt.Errorf("encode: %w", err)
+	}
+
+	return nil
+}
+
+// ImportIssueBundle
+// Recreate an issue from a bundle written by ExportIssueBundle with ImportIssue, keeping its authors and dates,
+// in queueKey or, if it is empty, in the queue it was exported from. Comments, the attachments exported with content
+// and links are restored after the issue; linked issues must exist in the target Tracker. Comments without text,
+// e.g. holding only files, cannot be imported and are skipped. Worklogs and changelog are kept in the bundle
+// for the record only, as the API cannot import them.
+// Once the issue is created it is returned even if restoring some of the rest fails, together with the joined errors
+func (t *TrackerClient) ImportIssueBundle(r io.Reader, queueKey string) (*Issue, error) {
+	bundle := new(IssueBundle)
+	if err := json.NewDecoder(r).Decode(bundle); err != nil {
+		return nil, fmt.Errorf("decode: %w", err)
+	}
+	if bundle.Version != issueBundleVersion {
+		return nil, &ValidationError{Field: "version", Reason: fmt.Sprintf("unsupported bundle version %d", bundle.Version)}
+	}
+
+	var source map[string]interface{}
+	if err := json.Unmarshal(bundle.Issue, &source); err != nil {
+		return nil, fmt.Errorf("decode issue: %w", err)
+	}
+	body := make(map[string]interface{}, len(issueBundleFields)+1)
+	for _, field := range issueBundleFields {
+		if value, ok := source[field]; ok && value != nil {
+			body[field] = referenceValue(value)
+		}
+	}
+	body["queue"] = referenceValue(source["queue"])
+	if queueKey != "" {
+		body["queue"] = t.CanonicalQueueKey(queueKey)
+	}
+
+	issue, _, err := t.ImportIssue(body)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []error
+	for _, comment := range bundle.Comments {
+		if comment.Text == "" {
+			continue
+		}
+		opts := &ImportCommentOptions{
+			Text:      comment.Text,
+			CreatedAt: comment.CreatedAt,
+			CreatedBy: comment.CreatedBy.Id(),
+			UpdatedBy: comment.UpdatedBy.Id(),
+		}
+		if !comment.UpdatedAt.IsZero() {
+			opts.UpdatedAt = &comment.UpdatedAt
+		}
+		if _, _, err := t.ImportComment(issue.Key, opts); err != nil {
+			errs = append(errs, fmt.Errorf("comment %d: %w", comment.ID, err))
+		}
+	}
+	for _, attachment := range bundle.Attachments {
+		if attachment.Attachment == nil || attachment.Data == nil {
+			continue
+		}
+		if err := t.importAttachment(issue.Key, attachment); err != nil {
+			errs = append(errs, fmt.Errorf("attachment %s: %w", attachment.Name, err))
+		}
+	}
+	for _, link := range bundle.Links {
+		if link.Object == nil || link.Type == nil {
+			continue
+		}
+		relationship, ok := linkRelationship(link)
+		if !ok {
+			errs = append(errs, fmt.Errorf("link %s: unknown link type %s", link.Object.Key, link.Type.ID))
+			continue
+		}
+		if _, _, err := t.LinkIssues(issue.Key, relationship, link.Object.Key); err != nil {
+			errs = append(errs, fmt.Errorf("link %s: %w", link.Object.Key, err))
+		}
+	}
+
+	return issue, errors.Join(errs...)
+}
+
+// importAttachment
+// Attach the exported file to the issue, keeping its author and upload date
+// https://cloud.yandex.ru/en/docs/tracker/concepts/import/import-attachments
+func (t *TrackerClient) importAttachment(issueKey string, attachment *BundleAttachment) error {
+	req := t.NewRequest(resty.MethodPost, "/v2/issues/"+issueKey+"/attachments/_import", nil).
+		SetQueryParam("filename", attachment.Name).
+		SetQueryParam("createdAt", attachment.CreatedAt.Format(timeLayout)).
+		SetQueryParam("createdBy", attachment.CreatedBy.Id())
+	if attachment.Mimetype == "" {
+		req.SetFileReader("file", attachment.Name, bytes.NewReader(attachment.Data))
+	} else {
+		req.SetMultipartField("file", attachment.Name, attachment.Mimetype, bytes.NewReader(attachment.Data))
+	}
+	if _, err := t.Do(req, nil); err != nil {
+		return fmt.Errorf("request: %w", err)
+	}
+
+	return nil
+}
+
+// Relationships that recreate a link of the type from the issue it was exported from, keyed by link type ID.
+var linkRelationships = map[string]struct{ inward, outward string }{
+	"relates":    {inward: "relates", outward: "relates"},
+	"depends":    {inward: "is dependent by", outward: "depends on"},
+	"subtask":    {inward: "is subtask for", outward: "is parent task for"},
+	"duplicates": {inward: "is duplicated by", outward: "duplicates"},
+	"epic":       {inward: "has epic", outward: "is epic of"},
+}
+
+// linkRelationship
+// Get the relationship that recreates the link from the issue it was exported from, e.g. depends on.
+// Reports false for link types without a known relationship
+func linkRelationship(link *IssueLink) (string, bool) {
+	relationship, ok := linkRelationships[link.Type.ID]
+	if !ok {
+		return "", false
+	}
+	if link.Direction == "inward" {
+		return relationship.inward, true
+	}
+
+	return relationship.outward, true
+}
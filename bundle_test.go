@@ -0,0 +1,100 @@
+package tracker
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestIssueBundleRoundTrip(t *testing.T) {
+	posted := make(map[string][]string)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			body, _ := io.ReadAll(r.Body)
+			posted[r.URL.Path] = append(posted[r.URL.Path], string(body))
+		}
+		switch r.Method + " " + r.URL.Path {
+		case "GET /v2/issues/OLD-1":
+			_, _ = w.Write([]byte(`{"key":"OLD-1","summary":"s","queue":{"key":"OLD","id":"1"},
+				"createdAt":"2024-01-01T00:00:00.000+0000","createdBy":{"id":"9"},"tags":["x"],"assignee":null}`))
+		case "GET /v2/issues/OLD-1/comments":
+			_, _ = w.Write([]byte(`[
+				{"id":1,"text":"hi","createdAt":"2024-01-01T00:00:00.000+0000","createdBy":{"id":"9"}},
+				{"id":2,"text":"","createdAt":"2024-01-01T00:00:00.000+0000","createdBy":{"id":"9"}}]`))
+		case "GET /v2/issues/OLD-1/attachments":
+			_, _ = w.Write([]byte(`[{"id":"7","name":"f.txt","content":"/v2/issues/OLD-1/attachments/7/f.txt",
+				"mimetype":"text/plain","createdBy":{"id":"9"},"createdAt":"2024-01-01T00:00:00.000+0000"},
+				{"id":"8","name":"empty.txt","content":"/v2/issues/OLD-1/attachments/8/empty.txt",
+				"mimetype":"text/plain","createdBy":{"id":"9"},"createdAt":"2024-01-01T00:00:00.000+0000"}]`))
+		case "GET /v2/issues/OLD-1/attachments/7/f.txt":
+			_, _ = w.Write([]byte("hello"))
+		case "GET /v2/issues/OLD-1/attachments/8/empty.txt":
+		case "GET /v2/issues/OLD-1/links":
+			_, _ = w.Write([]byte(`[{"id":1,"type":{"id":"depends","inward":"Блокирует","outward":"Зависит от"},
+				"direction":"outward","object":{"key":"OLD-2"}}]`))
+		case "GET /v2/issues/OLD-1/worklog", "GET /v2/issues/OLD-1/changelog":
+			_, _ = w.Write([]byte(`[]`))
+		case "POST /v2/issues/_import":
+			_, _ = w.Write([]byte(`{"key":"NEW-1"}`))
+		case "POST /v2/issues/NEW-1/comments/_import", "POST /v2/issues/NEW-1/attachments/_import",
+			"POST /v2/issues/NEW-1/links":
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	var bundle bytes.Buffer
+	if err := client.ExportIssueBundle("OLD-1", &bundle, true); err != nil {
+		t.Fatalf("ExportIssueBundle: %v", err)
+	}
+	if !strings.Contains(bundle.String(), `"data":"aGVsbG8="`) {
+		t.Errorf("bundle has no attachment content: %s", bundle.String())
+	}
+
+	issue, err := client.ImportIssueBundle(&bundle, "NEW")
+	if err != nil {
+		t.Fatalf("ImportIssueBundle: %v", err)
+	}
+	if issue.Key != "NEW-1" {
+		t.Errorf("key = %q", issue.Key)
+	}
+
+	var imported map[string]interface{}
+	if err := json.Unmarshal([]byte(posted["/v2/issues/_import"][0]), &imported); err != nil {
+		t.Fatal(err)
+	}
+	if imported["queue"] != "NEW" || imported["summary"] != "s" || imported["createdBy"] != "9" {
+		t.Errorf("imported issue = %v", imported)
+	}
+	if _, ok := imported["assignee"]; ok {
+		t.Errorf("null assignee imported: %v", imported)
+	}
+	if comments := posted["/v2/issues/NEW-1/comments/_import"]; len(comments) != 1 {
+		t.Errorf("imported comments = %q, want only the one with text", comments)
+	}
+	if attachments := posted["/v2/issues/NEW-1/attachments/_import"]; len(attachments) != 2 ||
+		!strings.Contains(attachments[0], "hello") || !strings.Contains(attachments[1], `filename="empty.txt"`) {
+		t.Errorf("imported attachments = %q, want f.txt and the empty file", attachments)
+	}
+	if links := posted["/v2/issues/NEW-1/links"]; len(links) != 1 ||
+		links[0] != `{"issue":"OLD-2","relationship":"depends on"}` {
+		t.Errorf("links = %q", links)
+	}
+
+	bundle.Reset()
+	if err := client.ExportIssueBundle("OLD-1", &bundle, false); err != nil {
+		t.Fatalf("ExportIssueBundle without content: %v", err)
+	}
+	delete(posted, "/v2/issues/NEW-1/attachments/_import")
+	if _, err := client.ImportIssueBundle(&bundle, "NEW"); err != nil {
+		t.Fatalf("ImportIssueBundle without content: %v", err)
+	}
+	if attachments := posted["/v2/issues/NEW-1/attachments/_import"]; len(attachments) != 0 {
+		t.Errorf("imported attachments = %q, want none without content", attachments)
+	}
+}
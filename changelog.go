@@ -0,0 +1,192 @@
+package tracker
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Maximum number of changelog entries per page accepted by the API.
+const changelogMaxPerPage = 50
+
+// ChangelogEntry structure in Yandex.Tracker
+// https://cloud.yandex.ru/en/docs/tracker/concepts/issues/get-changelog
+type ChangelogEntry struct {
+	// Address of the API resource with information about the change.
+	Self string `json:"self"`
+
+	// Change ID.
+	ID string `json:"id"`
+
+	// Object with information about the issue.
+	Issue *BasicIssue `json:"issue"`
+
+	// Date and time of the change.
+	UpdatedAt TrackerTime `json:"updatedAt"`
+
+	// Object with information about the user who made the change.
+	UpdatedBy *BasicUser `json:"updatedBy"`
+
+	// Change type, for example IssueCreated, IssueUpdated or IssueWorkflow.
+	Type string `json:"type"`
+
+	// Method of making the change, for example front or api.
+	Transport string `json:"transport"`
+
+	// Array of objects with information about the changed fields.
+	Fields []*FieldChangelog `json:"fields"`
+}
+
+type FieldChangelog struct {
+	// Object with information about the changed field.
+	Field *FieldRef `json:"field"`
+
+	// Field value before the change.
+	From interface{} `json:"from"`
+
+	// Field value after the change.
+	To interface{} `json:"to"`
+}
+
+type ListChangelogOptions struct {
+	// Number of changelog entries per response page. The maximum value is 50.
+	PerPage int
+
+	// ID of the change after which the requested page begins.
+	FromID string
+
+	// Only return changes of the field with this ID.
+	Field string
+
+	// Only return changes of this type, for example IssueWorkflow.
+	Type string
+}
+
+func (t *TrackerClient) GetChangelog(
+	issueKey string, opts *ListChangelogOptions,
+) ([]*ChangelogEntry, *resty.Response, error) {
+	req := t.NewRequest(resty.MethodGet, "/v2/issues/"+issueKey+"/changelog", nil)
+	if opts != nil {
+		if opts.PerPage > 0 {
+			req.SetQueryParam("perPage", fmt.Sprint(opts.PerPage))
+		}
+		if opts.FromID != "" {
+			req.SetQueryParam("id", opts.FromID)
+		}
+		if opts.Field != "" {
+			req.SetQueryParam("field", opts.Field)
+		}
+		if opts.Type != "" {
+			req.SetQueryParam("type", opts.Type)
+		}
+	}
+	var result []*ChangelogEntry
+	resp, err := t.Do(req, &result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+// GetAllChangelog
+// Get the whole issue changelog following pagination. The field and type filters of opts are kept for every page.
+func (t *TrackerClient) GetAllChangelog(issueKey string, opts *ListChangelogOptions) ([]*ChangelogEntry, error) {
+	pageOpts := ListChangelogOptions{PerPage: changelogMaxPerPage}
+	if opts != nil {
+		pageOpts.Field = opts.Field
+		pageOpts.Type = opts.Type
+	}
+
+	var result []*ChangelogEntry
+	for {
+		entries, resp, err := t.GetChangelog(issueKey, &pageOpts)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, entries...)
+		if !hasMore(resp, pageOpts.PerPage, len(entries)) {
+			return result, nil
+		}
+		pageOpts.FromID = entries[len(entries)-1].ID
+	}
+}
+
+// StatusDurations
+// Get the total time the issue spent in every status keyed by the status key, computed from status changes
+// in the changelog. The time in the current status is counted up to now.
+func (t *TrackerClient) StatusDurations(issueKey string) (map[string]time.Duration, error) {
+	issue, _, err := t.GetIssueFields(issueKey, []string{"status", "createdAt"})
+	if err != nil {
+		return nil, err
+	}
+	entries, err := t.GetAllChangelog(issueKey, &ListChangelogOptions{Field: "status"})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]time.Duration)
+	status, since := "", issue.CreatedAt.Time
+	for _, entry := range entries {
+		for _, change := range entry.Fields {
+			if change.Field == nil || change.Field.ID != "status" {
+				continue
+			}
+			if status == "" {
+				status = referenceKey(change.From)
+			}
+			if status != "" {
+				result[status] += entry.UpdatedAt.Sub(since)
+			}
+			status, since = referenceKey(change.To), entry.UpdatedAt.Time
+		}
+	}
+	if status == "" && issue.Status != nil {
+		status = issue.Status.Key
+	}
+	if status != "" {
+		result[status] += time.Since(since)
+	}
+
+	return result, nil
+}
+
+// FieldHistory
+// Get every change of the issue field with fieldKey from the changelog, oldest first, with the user who made it
+func (t *TrackerClient) FieldHistory(issueKey, fieldKey string) ([]*FieldChange, error) {
+	entries, err := t.GetAllChangelog(issueKey, &ListChangelogOptions{Field: fieldKey})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*FieldChange
+	for _, entry := range entries {
+		for _, change := range entry.Fields {
+			if change.Field == nil || change.Field.ID != fieldKey {
+				continue
+			}
+			result = append(result, &FieldChange{
+				Field:     fieldKey,
+				Old:       change.From,
+				New:       change.To,
+				UpdatedAt: entry.UpdatedAt,
+				UpdatedBy: entry.UpdatedBy,
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// referenceKey
+// Get the key of a reference object decoded from a changelog value such as a status
+func referenceKey(v interface{}) string {
+	if reference, ok := v.(map[string]interface{}); ok {
+		if key, ok := reference["key"].(string); ok {
+			return key
+		}
+	}
+
+	return ""
+}
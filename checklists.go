@@ -0,0 +1,173 @@
+package tracker
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// ChecklistItem structure in Yandex.Tracker
+// https://cloud.yandex.ru/en/docs/tracker/concepts/issues/get-checklist
+type ChecklistItem struct {
+	// Checklist item ID.
+	ID string `json:"id"`
+
+	// Text of the checklist item.
+	Text string `json:"text"`
+
+	// Text of the checklist item in HTML format.
+	TextHTML string `json:"textHtml"`
+
+	// Checklist item completion flag:
+	// true: Item is checked.
+	// false: Item is not checked.
+	Checked bool `json:"checked"`
+
+	// Object with information about the assignee of the checklist item.
+	Assignee *BasicUser `json:"assignee"`
+
+	// Object with information about the checklist item deadline.
+	Deadline *ChecklistDeadline `json:"deadline"`
+
+	// Type of the checklist item.
+	ChecklistItemType string `json:"checklistItemType"`
+}
+
+type ChecklistDeadline struct {
+	// Deadline date in the YYYY-MM-DDThh:mm:ss.sss±hhmm format.
+	Date TrackerTime `json:"date"`
+
+	// Deadline type. The only supported value is date.
+	DeadlineType string `json:"deadlineType"`
+
+	// Flag indicating that the deadline has passed.
+	IsExceeded bool `json:"isExceeded"`
+}
+
+func (t *TrackerClient) GetChecklist(issueKey string) ([]*ChecklistItem, *resty.Response, error) {
+	req := t.NewRequest(resty.MethodGet, "/v2/issues/"+issueKey+"/checklistItems", nil)
+	var result []*ChecklistItem
+	resp, err := t.Do(req, &result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+func (t *TrackerClient) ChecklistProgress(issueKey string) (done, total int, err error) {
+	items, _, err := t.GetChecklist(issueKey)
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, item := range items {
+		if item.Checked {
+			done++
+		}
+	}
+
+	return done, len(items), nil
+}
+
+type moveChecklistItemOptions struct {
+	// ID of the checklist item before which the moved item is placed.
+	Before string `json:"before"`
+}
+
+// MoveChecklistItem
+// Move the checklist item before the item with beforeItemID.
+// The API has no "after" position: to move an item after X, pass the item that follows X.
+func (t *TrackerClient) MoveChecklistItem(
+	issueKey, itemID, beforeItemID string,
+) (*Issue, *resty.Response, error) {
+	req := t.NewRequest(
+		resty.MethodPost,
+		"/v2/issues/"+issueKey+"/checklistItems/"+itemID+"/_move",
+		&moveChecklistItemOptions{Before: beforeItemID},
+	)
+	result := new(Issue)
+	resp, err := t.Do(req, result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+// MoveChecklistItemToTop
+// Move the checklist item to the first position. Returns an error matching ErrNotFound if the checklist has no such item
+func (t *TrackerClient) MoveChecklistItemToTop(issueKey, itemID string) (*Issue, *resty.Response, error) {
+	items, _, err := t.GetChecklist(issueKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	found := false
+	for _, item := range items {
+		if item.ID == itemID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, nil, fmt.Errorf("checklist item %s: %w", itemID, ErrNotFound)
+	}
+	if items[0].ID == itemID {
+		return t.GetIssue(issueKey)
+	}
+
+	return t.MoveChecklistItem(issueKey, itemID, items[0].ID)
+}
+
+// https://cloud.yandex.ru/en/docs/tracker/concepts/issues/edit-checklist-item
+type EditChecklistItemOptions struct {
+	// Text of the checklist item.
+	Text *string `json:"text,omitempty"`
+
+	// Checklist item completion flag.
+	Checked *bool `json:"checked,omitempty"`
+
+	// ID or username of the checklist item assignee.
+	// Object, number, or string.
+	Assignee interface{} `json:"assignee,omitempty"`
+
+	// Checklist item deadline.
+	Deadline *ChecklistDeadlineOptions `json:"deadline,omitempty"`
+}
+
+type ChecklistDeadlineOptions struct {
+	// Deadline date.
+	Date TrackerTime `json:"date"`
+
+	// Deadline type. The only supported value is date.
+	DeadlineType string `json:"deadlineType"`
+}
+
+func (t *TrackerClient) EditChecklistItem(
+	issueKey, itemID string, opts *EditChecklistItemOptions,
+) (*Issue, *resty.Response, error) {
+	req := t.NewRequest(resty.MethodPatch, "/v2/issues/"+issueKey+"/checklistItems/"+itemID, opts)
+	result := new(Issue)
+	resp, err := t.Do(req, result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+// SetChecklistItemDeadline
+// Set the checklist item deadline
+func (t *TrackerClient) SetChecklistItemDeadline(
+	issueKey, itemID string, deadline time.Time,
+) (*Issue, *resty.Response, error) {
+	return t.EditChecklistItem(issueKey, itemID, &EditChecklistItemOptions{
+		Deadline: &ChecklistDeadlineOptions{Date: TrackerTime{Time: deadline}, DeadlineType: "date"},
+	})
+}
+
+// AssignChecklistItem
+// Set the checklist item assignee by login
+func (t *TrackerClient) AssignChecklistItem(issueKey, itemID, login string) (*Issue, *resty.Response, error) {
+	return t.EditChecklistItem(issueKey, itemID, &EditChecklistItemOptions{Assignee: login})
+}
@@ -0,0 +1,39 @@
+package tracker
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestMoveChecklistItemToTop(t *testing.T) {
+	var moves []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /v2/issues/TEST-1/checklistItems":
+			_, _ = w.Write([]byte(`[{"id":"a"},{"id":"b"}]`))
+		case "POST /v2/issues/TEST-1/checklistItems/b/_move":
+			body, _ := io.ReadAll(r.Body)
+			moves = append(moves, string(body))
+			_, _ = w.Write([]byte(`{"key":"TEST-1"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	if _, _, err := client.MoveChecklistItemToTop("TEST-1", "b"); err != nil {
+		t.Fatalf("MoveChecklistItemToTop: %v", err)
+	}
+	if len(moves) != 1 || moves[0] != `{"before":"a"}` {
+		t.Errorf("moves = %q", moves)
+	}
+
+	if _, _, err := client.MoveChecklistItemToTop("TEST-1", "missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("err = %v, want ErrNotFound", err)
+	}
+	if len(moves) != 1 {
+		t.Errorf("unknown item was moved: %q", moves)
+	}
+}
@@ -1,9 +1,17 @@
 package tracker
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"log"
 	"net/http"
+	"os"
+	"sync"
+	"time"
 
 	"github.com/go-resty/resty/v2"
 )
@@ -21,21 +29,309 @@ const (
 type Client interface {
 	// GetTicket - get Yandex.Tracker ticket by ticket keys
 	GetTicket(ticketKey string) (ticket Ticket, err error)
+	// GetTicketInto - get Yandex.Tracker ticket by ticket key and unmarshal it into v
+	GetTicketInto(ticketKey string, v interface{}) error
 	// PatchTicket - patch Yandex.Tracker ticket by ticket key
 	PatchTicket(ticketKey string, body map[string]string) (ticket Ticket, err error)
 	// GetTicketComments - get Yandex.Tracker ticket comments by ticket key
 	GetTicketComments(ticketKey string) (comments TicketComments, err error)
 	// Myself - get information about the current Yandex.Tracker user
 	Myself() (user *User, err error)
+	// Ping - check connectivity and authorization in Yandex.Tracker
+	Ping(ctx context.Context) error
+	// GetOrganization - get information about the Yandex.Tracker organization the client operates on
+	GetOrganization() (*Organization, error)
 	// CreateIssue - create Yandex.Tracker issue
 	CreateIssue(opts *CreateIssueOptions) (issue *Issue, response *resty.Response, err error)
+	// CreateIssueValidated - create Yandex.Tracker issue after checking the options against the queue fields
+	CreateIssueValidated(opts *CreateIssueOptions) (*Issue, error)
+	// CloneIssue - create a copy of Yandex.Tracker issue
+	CloneIssue(issueKey string, opts *CloneIssueOptions) (*Issue, error)
 	// FindIssues - search Yandex.Tracker issues
 	FindIssues(opts *FindIssuesOptions, listOpts *ListOptions) (issues []*Issue, response *resty.Response, err error)
-	// GetIssue - get Yandex.Tracker issue by key
+	// CountIssues - count Yandex.Tracker issues matching the search
+	CountIssues(opts *FindIssuesOptions) (int, *resty.Response, error)
+	// FindIssuesRaw - search Yandex.Tracker issues with an arbitrary request body
+	FindIssuesRaw(body json.RawMessage, listOpts *ListOptions) ([]*Issue, *resty.Response, error)
+	// GetIssue - get Yandex.Tracker issue by key (QUEUE-123) or ID, the endpoint accepts both
 	GetIssue(issueKey string) (*Issue, *resty.Response, error)
+	// GetIssueRaw - get Yandex.Tracker issue as raw JSON
+	GetIssueRaw(issueKey string) (json.RawMessage, *resty.Response, error)
+	// GetIssueFields - get Yandex.Tracker issue with only the listed fields
+	GetIssueFields(issueKey string, fields []string) (*Issue, *resty.Response, error)
+	// GetIssueIfModifiedSince - get Yandex.Tracker issue only if it was updated after the moment
+	GetIssueIfModifiedSince(issueKey string, since time.Time) (*Issue, bool, error)
+	// GetIssueSLA - get Yandex.Tracker issue SLA timers
+	GetIssueSLA(issueKey string) ([]*SLA, error)
+	// GetVoters - get users who voted for Yandex.Tracker issue
+	GetVoters(issueKey string) ([]*BasicUser, error)
+	// GetIssues - get Yandex.Tracker issues by keys concurrently
+	GetIssues(keys []string, fields []string) ([]*Issue, error)
+	// GetIssuesBatch - get Yandex.Tracker issues by keys with one search request per 50 keys
+	GetIssuesBatch(keys []string) ([]*Issue, error)
+	// IssueExists - check whether Yandex.Tracker issue with the key exists
+	IssueExists(issueKey string) (bool, error)
+	// FollowMovedIssue - get Yandex.Tracker issue by a key it had before being moved
+	FollowMovedIssue(oldKey string) (*Issue, error)
+	// ImportIssue - import Yandex.Tracker issue keeping its authors and dates
+	ImportIssue(body map[string]interface{}) (*Issue, *resty.Response, error)
+	// ImportComment - import Yandex.Tracker issue comment keeping its author and dates
+	ImportComment(issueKey string, opts *ImportCommentOptions) (*Comment, *resty.Response, error)
+	// ImportFromReader - create Yandex.Tracker issues from CSV rows or JSON lines
+	ImportFromReader(r io.Reader, format string, mapping FieldMapping) ([]*ImportResult, error)
+	// SetTags - make Yandex.Tracker issue tags equal to the set, sending only the changes
+	SetTags(issueKey string, tags []string) ([]string, error)
+	// UpsertIssue - create Yandex.Tracker issue by unique value or update the existing one
+	UpsertIssue(uniqueKey string, opts *CreateIssueOptions, update *UpdateIssueOptions) (*Issue, bool, error)
+	// UpdateIssue - update Yandex.Tracker issue fields
+	UpdateIssue(issueKey string, opts *UpdateIssueOptions) (*Issue, *resty.Response, error)
+	// ChangeIssueType - change Yandex.Tracker issue type, reporting fields the new type requires
+	ChangeIssueType(issueKey, typeKey string) (*Issue, error)
+	// SetDates - set Yandex.Tracker issue start and end dates
+	SetDates(issueKey string, opts *SetDatesOptions) (*Issue, error)
+	// SetUserField - replace the users in Yandex.Tracker issue user field
+	SetUserField(issueKey, fieldKey string, logins []string) (*Issue, error)
+	// GetPriorities - get Yandex.Tracker priorities
+	GetPriorities() ([]*Priority, *resty.Response, error)
+	// SetPriority - set Yandex.Tracker issue priority by key
+	SetPriority(issueKey, priorityKey string) (*Issue, error)
+	// UpdateIssueRaw - patch Yandex.Tracker issue with an arbitrary JSON body
+	UpdateIssueRaw(issueKey string, body map[string]interface{}) (*Issue, *resty.Response, error)
+	// WatchIssue - add the current user to Yandex.Tracker issue followers
+	WatchIssue(issueKey string) (*Issue, *resty.Response, error)
+	// UnwatchIssue - remove the current user from Yandex.Tracker issue followers
+	UnwatchIssue(issueKey string) (*Issue, *resty.Response, error)
+	// FindAllIssues - get all Yandex.Tracker issues matching the search following pagination
+	FindAllIssues(opts *FindIssuesOptions) ([]*Issue, error)
+	// FindUpdatedSince - get a page of Yandex.Tracker queue issues updated since the moment
+	FindUpdatedSince(queueKey string, since time.Time, listOpts *ListOptions) ([]*Issue, *resty.Response, error)
+	// FindDueSoon - get a page of Yandex.Tracker queue issues with the deadline coming soon
+	FindDueSoon(queueKey string, within time.Duration, listOpts *ListOptions) ([]*Issue, *resty.Response, error)
+	// FindIssuesUpdatedBy - get a page of Yandex.Tracker issues updated by the user since the moment
+	FindIssuesUpdatedBy(login string, since time.Time, listOpts *ListOptions) ([]*Issue, *resty.Response, error)
+	// GetEpicIssues - get a page of Yandex.Tracker issues under the epic
+	GetEpicIssues(epicKey string, listOpts *ListOptions) ([]*Issue, *resty.Response, error)
+	// GetEpicTree - get Yandex.Tracker epic with the issues below it level by level
+	GetEpicTree(epicKey string) (*IssueTree, error)
+	// GetIssueFull - get Yandex.Tracker issue with its comments, attachments and links
+	GetIssueFull(issueKey string) (*FullIssue, error)
+	// ExportIssueBundle - write Yandex.Tracker issue with its comments, attachments, links, worklogs and changelog as JSON
+	ExportIssueBundle(issueKey string, w io.Writer, withContent bool) error
+	// ImportIssueBundle - recreate Yandex.Tracker issue from a bundle written by ExportIssueBundle
+	ImportIssueBundle(r io.Reader, queueKey string) (*Issue, error)
+	// GetIssueWithEpic - get Yandex.Tracker issue with the summary and status of its epic
+	GetIssueWithEpic(issueKey string) (*IssueWithEpic, error)
+	// GetAttachments - get files attached to Yandex.Tracker issue
+	GetAttachments(issueKey string) ([]*Attachment, *resty.Response, error)
+	// AttachFile - attach a file to Yandex.Tracker issue
+	AttachFile(issueKey, fileName string, r io.Reader) (*Attachment, *resty.Response, error)
+	// AttachFileWithType - attach a file with the MIME type to Yandex.Tracker issue
+	AttachFileWithType(issueKey, fileName, contentType string, r io.Reader) (*Attachment, *resty.Response, error)
+	// UploadTemporaryAttachment - upload a file to attach to Yandex.Tracker issue or comment later
+	UploadTemporaryAttachment(fileName string, r io.Reader) (*Attachment, *resty.Response, error)
+	// GetAttachment - get metadata of a file attached to Yandex.Tracker issue, ErrNotFound if it was deleted
+	GetAttachment(issueKey, attachmentID string) (*Attachment, *resty.Response, error)
+	// DownloadAttachment - write the content of Yandex.Tracker attachment
+	DownloadAttachment(ctx context.Context, attachment *Attachment, w io.Writer) (int64, error)
+	// DownloadAllAttachments - save all files attached to Yandex.Tracker issue into a directory
+	DownloadAllAttachments(issueKey, destDir string) (int, error)
+	// GetLinks - get Yandex.Tracker issue links to other issues
+	GetLinks(issueKey string) ([]*IssueLink, *resty.Response, error)
+	// LinkIssues - link Yandex.Tracker issue to another one
+	LinkIssues(issueKey, relationship, otherKey string) (*IssueLink, *resty.Response, error)
+	// FindIssuesStream - search Yandex.Tracker issues decoding the response incrementally
+	FindIssuesStream(opts *FindIssuesOptions, listOpts *ListOptions, fn func(issue *Issue) error) (*resty.Response, error)
+	// FindIssuesScroll - scroll through all Yandex.Tracker issues matching the search
+	FindIssuesScroll(opts *FindIssuesOptions, scrollOpts *ScrollOptions, fn func(issues []*Issue) error) error
+	// FindIssuesCursor - get a page of Yandex.Tracker issues matching the search at a resumable cursor
+	FindIssuesCursor(opts *FindIssuesOptions, cursor *Cursor, perPage int) ([]*Issue, *Cursor, error)
+	// FindIssuesScrollCursor - get a scroll page of Yandex.Tracker issues matching the search at a resumable cursor
+	FindIssuesScrollCursor(opts *FindIssuesOptions, scrollOpts *ScrollOptions, cursor *Cursor) ([]*Issue, *Cursor, error)
+	// CountByQueue - count Yandex.Tracker issues of every queue concurrently
+	CountByQueue(queueKeys []string, extraQuery string) (map[string]int, error)
+	// QueueSummaries - get Yandex.Tracker queues with the number of their open issues
+	QueueSummaries() ([]*QueueSummary, error)
+	// AggregateTags - count Yandex.Tracker issues matching the search by tag
+	AggregateTags(opts *FindIssuesOptions) ([]*TagCount, error)
+	// SumSpentTime - sum the time spent on Yandex.Tracker issues matching the search
+	SumSpentTime(opts *FindIssuesOptions, progress func(issues int, spent time.Duration)) (time.Duration, error)
+	// GetComments - get a page of Yandex.Tracker issue comments by issue key
+	GetComments(issueKey string, opts *ListCommentsOptions) ([]*Comment, *resty.Response, error)
+	// GetComment - get Yandex.Tracker issue comment by ID
+	GetComment(issueKey, commentID, expand string) (*Comment, *resty.Response, error)
+	// GetCommentAttachments - get files attached to Yandex.Tracker issue comment
+	GetCommentAttachments(issueKey, commentID string) ([]*Attachment, error)
+	// GetAllComments - get all Yandex.Tracker issue comments following pagination
+	GetAllComments(issueKey string) ([]*Comment, error)
+	// GetLatestComments - get the last Yandex.Tracker issue comments, newest first
+	GetLatestComments(issueKey string, n int) ([]*Comment, error)
+	// CommentCount - get the number of Yandex.Tracker issue comments
+	CommentCount(issueKey string) (int, error)
+	// GetCommentsSince - get Yandex.Tracker issue comments created or updated after since
+	GetCommentsSince(issueKey string, since time.Time) ([]*Comment, error)
+	// GetUsers - get a page of Yandex.Tracker organization users
+	GetUsers(listOpts *ListOptions) ([]*User, *resty.Response, error)
+	// GetUser - get Yandex.Tracker user by login or uid
+	GetUser(loginOrUID string) (*User, *resty.Response, error)
+	// ResolveUser - get Yandex.Tracker user by login or uid with caching
+	ResolveUser(loginOrID string) (*User, error)
+	// GetAllUsers - get all Yandex.Tracker organization users following pagination
+	GetAllUsers() ([]*User, error)
+	// HydrateCommentAuthors - resolve Yandex.Tracker comment authors into full users
+	HydrateCommentAuthors(comments []*Comment) ([]*CommentWithAuthor, error)
+	// AddComment - add a comment to Yandex.Tracker issue
+	AddComment(issueKey string, opts *AddCommentOptions) (*Comment, *resty.Response, error)
+	// BulkAddComment - add the same comment to many Yandex.Tracker issues concurrently
+	BulkAddComment(keys []string, text string) (map[string]error, error)
+	// ReassignAll - assign all Yandex.Tracker issues of one user to another
+	ReassignAll(fromLogin, toLogin, queueKey string) (int, error)
+	// GetChecklist - get Yandex.Tracker issue checklist items
+	GetChecklist(issueKey string) ([]*ChecklistItem, *resty.Response, error)
+	// ChecklistProgress - get the number of checked and total Yandex.Tracker issue checklist items
+	ChecklistProgress(issueKey string) (done, total int, err error)
+	// MoveChecklistItem - move Yandex.Tracker checklist item before another item
+	MoveChecklistItem(issueKey, itemID, beforeItemID string) (*Issue, *resty.Response, error)
+	// MoveChecklistItemToTop - move Yandex.Tracker checklist item to the first position
+	MoveChecklistItemToTop(issueKey, itemID string) (*Issue, *resty.Response, error)
+	// EditChecklistItem - edit Yandex.Tracker checklist item
+	EditChecklistItem(issueKey, itemID string, opts *EditChecklistItemOptions) (*Issue, *resty.Response, error)
+	// SetChecklistItemDeadline - set Yandex.Tracker checklist item deadline
+	SetChecklistItemDeadline(issueKey, itemID string, deadline time.Time) (*Issue, *resty.Response, error)
+	// AssignChecklistItem - set Yandex.Tracker checklist item assignee
+	AssignChecklistItem(issueKey, itemID, login string) (*Issue, *resty.Response, error)
+	// AddRemoteLink - link Yandex.Tracker issue to an object of an external application
+	AddRemoteLink(issueKey string, opts *RemoteLinkOptions) (*RemoteLink, *resty.Response, error)
+	// GetRemoteLinks - get Yandex.Tracker issue links to external applications
+	GetRemoteLinks(issueKey string) ([]*RemoteLink, *resty.Response, error)
+	// ExportIssuesCSV - write Yandex.Tracker issues matching the search as CSV rows
+	ExportIssuesCSV(w io.Writer, opts *FindIssuesOptions, fields []string) error
+	// ExportIssuesCSVScroll - write Yandex.Tracker issues matching the search as CSV using scroll search
+	ExportIssuesCSVScroll(w io.Writer, opts *FindIssuesOptions, scrollOpts *ScrollOptions, fields []string) error
+	// GetTransitions - get transitions available for Yandex.Tracker issue
+	GetTransitions(issueKey string) ([]*Transition, *resty.Response, error)
+	// FindTransitionTo - get Yandex.Tracker issue transition leading to the target status
+	FindTransitionTo(issueKey, targetStatusKey string) (*Transition, error)
+	// AllowedNextStatuses - get the statuses Yandex.Tracker issue can be moved to
+	AllowedNextStatuses(issueKey string) ([]*BasicStatus, error)
+	// GetIssueTransitions - get Yandex.Tracker issue transitions using the transition cache
+	GetIssueTransitions(issue *Issue) ([]*Transition, error)
+	// FindIssueTransitionTo - find Yandex.Tracker issue transition to the status using the transition cache
+	FindIssueTransitionTo(issue *Issue, targetStatusKey string) (*Transition, error)
+	// ExecuteTransition - execute Yandex.Tracker issue transition
+	ExecuteTransition(issueKey, transitionID string, opts *ExecuteTransitionOptions) ([]*Transition, *resty.Response, error)
+	// CanTransition - check that Yandex.Tracker issue transition is available and its required fields are set
+	CanTransition(issueKey, transitionID string, opts *ExecuteTransitionOptions) (bool, error)
+	// ReopenIssue - execute the transition reopening a closed Yandex.Tracker issue
+	ReopenIssue(issueKey, comment string) (*Issue, error)
+	// ResolveWithComment - execute the transition closing Yandex.Tracker issue with a resolution and a comment
+	ResolveWithComment(issueKey, resolution, commentText string) (*Issue, error)
+	// GetTransitionScreen - get the fields asked for when executing Yandex.Tracker issue transition
+	GetTransitionScreen(issueKey, transitionID string) (*TransitionScreen, error)
+	// GetFields - get global Yandex.Tracker fields
+	GetFields() ([]*Field, *resty.Response, error)
+	// GetField - get Yandex.Tracker field by ID
+	GetField(fieldID string) (*Field, *resty.Response, error)
+	// LoadFieldSchema - get the cached value schema of Yandex.Tracker global and queue fields
+	LoadFieldSchema(queueKeys ...string) (map[string]*FieldSchema, error)
+	// RefreshFieldSchema - drop the cached Yandex.Tracker field schema
+	RefreshFieldSchema()
+	// DecodeField - decode Yandex.Tracker issue field into a value of its schema type
+	DecodeField(issue *Issue, fieldKey string) (interface{}, error)
+	// GetFieldOptions - get allowed values of Yandex.Tracker enumerated field
+	GetFieldOptions(fieldID string) ([]*FieldOption, error)
+	// GetFieldCategories - get Yandex.Tracker field categories
+	GetFieldCategories() ([]*FieldCategory, *resty.Response, error)
+	// GetWorklogs - get Yandex.Tracker issue time tracking records
+	GetWorklogs(issueKey string) ([]*Worklog, *resty.Response, error)
+	// AddWorklog - add a worklog record to Yandex.Tracker issue
+	AddWorklog(issueKey string, opts *AddWorklogOptions) (*Worklog, *resty.Response, error)
+	// LogTime - add a worklog record of work started now to Yandex.Tracker issue
+	LogTime(issueKey, duration, comment string) (*Worklog, error)
+	// SetEstimation - set Yandex.Tracker issue remaining time estimate
+	SetEstimation(issueKey string, d time.Duration) (*Issue, error)
+	// SetSpent - overwrite the time spent on Yandex.Tracker issue without a worklog record
+	SetSpent(issueKey string, d time.Duration) (*Issue, error)
+	// GetQueues - get a page of Yandex.Tracker queues
+	GetQueues(listOpts *ListOptions) ([]*Queue, *resty.Response, error)
+	// GetMyQueues - get all Yandex.Tracker queues the current user has access to
+	GetMyQueues() ([]*Queue, error)
+	// CanonicalQueueKey - get the current Yandex.Tracker queue key for an alias
+	CanonicalQueueKey(queueKey string) string
+	// GetQueue - get Yandex.Tracker queue by key
+	GetQueue(queueKey, expand string) (*Queue, *resty.Response, error)
+	// GetQueueWorkflows - get Yandex.Tracker queue workflows with their statuses and transitions
+	GetQueueWorkflows(queueKey string) ([]*Workflow, error)
+	// TransitionTo - move Yandex.Tracker issue to the status through as many transitions as needed
+	TransitionTo(issueKey, targetStatusKey string) (*Issue, error)
+	// GetQueueIssues - get a page of Yandex.Tracker queue issues
+	GetQueueIssues(queueKey string, listOpts *ListOptions) ([]*Issue, *resty.Response, error)
+	// GetAutoactions - get Yandex.Tracker queue autoactions
+	GetAutoactions(queueKey string) ([]*Autoaction, *resty.Response, error)
+	// GetAutoaction - get Yandex.Tracker queue autoaction by ID
+	GetAutoaction(queueKey, autoactionID string) (*Autoaction, *resty.Response, error)
+	// GetQueueIssueTypes - get the issue types enabled in Yandex.Tracker queue
+	GetQueueIssueTypes(queueKey string) ([]*IssueType, error)
+	// GetQueueFields - get fields available in Yandex.Tracker queue
+	GetQueueFields(queueKey string) ([]*Field, *resty.Response, error)
+	// QueueDefaults - get Yandex.Tracker queue default type, priority and required fields
+	QueueDefaults(queueKey string) (*QueueDefaults, error)
+	// CreateQueueField - create a local field in Yandex.Tracker queue
+	CreateQueueField(queueKey string, opts *CreateFieldOptions) (*Field, *resty.Response, error)
+	// BulkMove - move Yandex.Tracker issues to another queue in bulk
+	BulkMove(opts *BulkMoveOptions) (*BulkOperation, *resty.Response, error)
+	// BulkGetStatus - get Yandex.Tracker bulk operation status
+	BulkGetStatus(bulkID string) (*BulkOperation, *resty.Response, error)
+	// WaitForBulk - poll Yandex.Tracker bulk operation until it is done
+	WaitForBulk(ctx context.Context, bulkID string, pollInterval time.Duration) (*BulkOperation, error)
+	// GetBoard - get Yandex.Tracker board by ID
+	GetBoard(boardID string) (*Board, *resty.Response, error)
+	// GetIssueBoards - get Yandex.Tracker boards the issue is on
+	GetIssueBoards(issueKey string) ([]*Board, error)
+	// GetBoardColumns - get Yandex.Tracker board columns
+	GetBoardColumns(boardID string) ([]*BoardColumn, *resty.Response, error)
+	// GroupSprintIssuesByStatus - get Yandex.Tracker sprint issues grouped by board column
+	GroupSprintIssuesByStatus(boardID, sprintID string) (map[string][]*Issue, error)
+	// GetSprint - get Yandex.Tracker sprint
+	GetSprint(sprintID string) (*Sprint, *resty.Response, error)
+	// GetIssueSprints - get Yandex.Tracker sprints the issue is planned in
+	GetIssueSprints(issueKey string) ([]*Sprint, error)
+	// GetChangelog - get a page of Yandex.Tracker issue changelog
+	GetChangelog(issueKey string, opts *ListChangelogOptions) ([]*ChangelogEntry, *resty.Response, error)
+	// GetAllChangelog - get the whole Yandex.Tracker issue changelog following pagination
+	GetAllChangelog(issueKey string, opts *ListChangelogOptions) ([]*ChangelogEntry, error)
+	// FieldHistory - get the changes of Yandex.Tracker issue field from the changelog
+	FieldHistory(issueKey, fieldKey string) ([]*FieldChange, error)
+	// StatusDurations - get the time Yandex.Tracker issue spent in every status
+	StatusDurations(issueKey string) (map[string]time.Duration, error)
+	// GetActivity - get Yandex.Tracker issue comments, changelog and worklog as one timeline
+	GetActivity(issueKey string) ([]*ActivityEvent, error)
+	// GetBySelf - fetch Yandex.Tracker resource by its self link
+	GetBySelf(selfURL string, v interface{}) (*resty.Response, error)
+	// BatchExecute - run fn for every key with bounded concurrency and the client's rate limit
+	BatchExecute(ctx context.Context, keys []string, fn func(ctx context.Context, key string) error) error
 
 	WithLogger(l resty.Logger)
 	WithDebug(d bool)
+	WithDryRun(d bool)
+	WithRetry(count int, waitTime, maxWaitTime time.Duration)
+	WithRetryCondition(condition func(*resty.Response, error) bool)
+	WithRetryNonIdempotent(retry bool)
+	WithBeforeRetry(fn func(attempt int, resp *resty.Response, err error))
+	WithRateLimit(requestsPerSecond int)
+	WithConcurrency(n int)
+	WithUseNumber(u bool)
+	WithErrorBodyLimit(n int)
+	WithTokenProvider(p TokenProvider)
+	WithTransitionCache(ttl time.Duration)
+	WithMiddleware(middlewares ...Middleware)
+	WithResponseMiddleware(fn func(v interface{}))
+	WithMaxResponseBytes(n int64)
+	WithRequestIDFunc(fn func() string)
+	WithQueueAliases(aliases map[string]string)
+	WithRedirectPolicy(policies ...resty.RedirectPolicy)
+	WithLanguage(lang string)
+	WithDefaultQueue(queueKey string)
+	WithTransportConfig(maxIdleConns, maxIdleConnsPerHost int, idleTimeout time.Duration)
 }
 
 func New(token, xOrgID, xCloudOrgID string) *TrackerClient {
@@ -51,25 +347,75 @@ func New(token, xOrgID, xCloudOrgID string) *TrackerClient {
 		headers["X-Org-Id"] = xOrgID
 	}
 
-	return &TrackerClient{
-		client:  resty.New(),
-		headers: headers,
+	t := &TrackerClient{
+		client:         resty.New().SetRedirectPolicy(resty.FlexibleRedirectPolicy(defaultMaxRedirects)),
+		headers:        headers,
+		logger:         &stdLogger{l: log.New(os.Stderr, "TRACKER ", log.LstdFlags)},
+		errorBodyLimit: defaultErrorBodyLimit,
 	}
+	t.client.AddRetryCondition(withinRetryBudget(t.defaultRetryCondition))
+
+	return t
 }
 
 type TrackerClient struct {
-	headers map[string]string
-	client  *resty.Client
+	headers        map[string]string
+	client         *resty.Client
+	logger         resty.Logger
+	dryRun         bool
+	limiter        *rateLimiter
+	concurrency    int
+	errorBodyLimit int
+	useNumber      bool
+	defaultQueue   string
+	tokenProvider  TokenProvider
+	transitions    *transitionCache
+	transport      http.RoundTripper
+	middlewares    []Middleware
+	responseHooks  []func(v interface{})
+	queueAliases   map[string]string
+
+	retryNonIdempotent bool
+
+	usersMu sync.Mutex
+	users   map[string]*User
+
+	prioritiesMu sync.Mutex
+	priorities   []*Priority
+
+	fieldSchemaMu     sync.Mutex
+	fieldSchema       map[string]*FieldSchema
+	fieldSchemaQueues map[string]bool
 }
 
 func (t *TrackerClient) WithLogger(l resty.Logger) {
 	t.client.SetLogger(l)
+	t.logger = l
 }
 
 func (t *TrackerClient) WithDebug(d bool) {
 	t.client.SetDebug(d)
 }
 
+// WithUseNumber
+// Decode numbers in untyped values, e.g. Ticket fields or custom fields in maps,
+// as json.Number instead of float64 so that large integer ids keep their precision.
+func (t *TrackerClient) WithUseNumber(u bool) {
+	t.useNumber = u
+}
+
+// WithLanguage
+// Send the Accept-Language header, e.g. en or ru, with every request, so display names of statuses,
+// priorities and other references are localized in searches as well as in single issues.
+// CreateIssueOptions.Language overrides it for one request; an empty lang removes the header.
+func (t *TrackerClient) WithLanguage(lang string) {
+	if lang == "" {
+		delete(t.headers, "Accept-Language")
+		return
+	}
+	t.headers["Accept-Language"] = lang
+}
+
 func (t *TrackerClient) NewRequest(method, path string, opt interface{}) *resty.Request {
 	req := t.client.R()
 	req.Method = method
@@ -80,43 +426,82 @@ func (t *TrackerClient) NewRequest(method, path string, opt interface{}) *resty.
 	return req.SetHeaders(t.headers)
 }
 
+// Do
+// Send the request and decode the response body into v. An empty body, e.g. 204 No Content, leaves v unchanged
 func (t *TrackerClient) Do(req *resty.Request, v interface{}) (*resty.Response, error) {
-	resp, err := req.Send()
+	if t.dryRun && isMutation(req) {
+		return t.dryRunResponse(req), nil
+	}
+	if err := t.limiter.wait(req.Context()); err != nil {
+		return nil, fmt.Errorf("rate limit: %w", err)
+	}
+	resp, err := t.send(req)
 	if err != nil {
 		return nil, fmt.Errorf("request: %w", err)
 	}
 	if resp.IsError() {
-		return nil, fmt.Errorf(
-			"wrong status code: %d, message=%s, headers=%s", resp.StatusCode(), string(resp.Body()), t.headers,
-		)
+		return nil, t.newAPIError(resp.StatusCode(), resp.Header(), resp.Body())
 	}
-	if err := json.Unmarshal(resp.Body(), v); err != nil {
+	if v == nil || isEmptyBody(resp) {
+		return resp, nil
+	}
+	if err := t.unmarshal(resp.Body(), v); err != nil {
 		return nil, fmt.Errorf("json.Unmarshal: %w", err)
 	}
+	for _, hook := range t.responseHooks {
+		hook(v)
+	}
 	return resp, nil
 }
 
+// isEmptyBody
+// Report whether the response has no content to decode, e.g. 204 No Content or a patch that changed nothing
+func isEmptyBody(resp *resty.Response) bool {
+	return resp.StatusCode() == http.StatusNoContent || len(bytes.TrimSpace(resp.Body())) == 0
+}
+
 func (t *TrackerClient) GetTicket(ticketKey string) (Ticket, error) {
+	var result Ticket
+	if err := t.GetTicketInto(ticketKey, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (t *TrackerClient) GetTicketInto(ticketKey string, v interface{}) error {
 	request := t.client.R().SetHeaders(t.headers)
 	resp, err := request.Get(ticketUrl + ticketKey)
 	if err != nil {
-		return nil, fmt.Errorf("request: %w", err)
+		return fmt.Errorf("request: %w", err)
 	}
 
 	if resp.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("wrong status code: %d, message=%s", resp.StatusCode(), string(resp.Body()))
+		return t.newAPIError(resp.StatusCode(), resp.Header(), resp.Body())
 	}
 
-	var result Ticket
-	if err := json.Unmarshal(resp.Body(), &result); err != nil {
-		return nil, fmt.Errorf("json.Unmarshal: %w", err)
+	if err := t.unmarshal(resp.Body(), v); err != nil {
+		return fmt.Errorf("json.Unmarshal: %w", err)
 	}
 
-	return result, nil
+	return nil
 }
 
+// PatchTicket
+// Patch ticket fields. When the API answers with an empty body, e.g. 204 for a patch that changed nothing,
+// the ticket is fetched again and returned as is
 func (t *TrackerClient) PatchTicket(ticketKey string, body map[string]string) (Ticket, error) {
+	if len(body) == 0 {
+		return nil, &ValidationError{Field: "body", Reason: "must not be empty"}
+	}
+
 	request := t.client.R().SetHeaders(t.headers)
+	if t.dryRun {
+		request.Method = resty.MethodPatch
+		request.URL = ticketUrl + ticketKey
+		t.dryRunResponse(request.SetBody(body))
+		return Ticket{}, nil
+	}
 	resp, err := request.
 		SetBody(body).
 		Patch(ticketUrl + ticketKey)
@@ -124,12 +509,15 @@ func (t *TrackerClient) PatchTicket(ticketKey string, body map[string]string) (T
 		return nil, fmt.Errorf("request: %w", err)
 	}
 
+	if resp.StatusCode() == http.StatusNoContent || resp.StatusCode() == http.StatusOK && isEmptyBody(resp) {
+		return t.GetTicket(ticketKey)
+	}
 	if resp.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("wrong status code: %d, message=%s", resp.StatusCode(), string(resp.Body()))
+		return nil, t.newAPIError(resp.StatusCode(), resp.Header(), resp.Body())
 	}
 
 	var result Ticket
-	if err := json.Unmarshal(resp.Body(), &result); err != nil {
+	if err := t.unmarshal(resp.Body(), &result); err != nil {
 		return nil, fmt.Errorf("json.Unmarshal: %w", err)
 	}
 
@@ -144,13 +532,46 @@ func (t *TrackerClient) GetTicketComments(ticketKey string) (TicketComments, err
 	}
 
 	if resp.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("wrong status code: %d, message=%s", resp.StatusCode(), string(resp.Body()))
+		return nil, t.newAPIError(resp.StatusCode(), resp.Header(), resp.Body())
 	}
 
 	var result TicketComments
-	if err := json.Unmarshal(resp.Body(), &result); err != nil {
+	if err := t.unmarshal(resp.Body(), &result); err != nil {
 		return nil, fmt.Errorf("json.Unmarshal: %w", err)
 	}
 
 	return result, nil
 }
+
+func (t *TrackerClient) unmarshal(data []byte, v interface{}) error {
+	if !t.useNumber {
+		return json.Unmarshal(data, v)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(v); err != nil {
+		return err
+	}
+	if dec.More() {
+		return errors.New("invalid character after top-level value")
+	}
+
+	return nil
+}
+
+type stdLogger struct {
+	l *log.Logger
+}
+
+func (s *stdLogger) Errorf(format string, v ...interface{}) {
+	s.l.Printf("ERROR "+format, v...)
+}
+
+func (s *stdLogger) Warnf(format string, v ...interface{}) {
+	s.l.Printf("WARN "+format, v...)
+}
+
+func (s *stdLogger) Debugf(format string, v ...interface{}) {
+	s.l.Printf("DEBUG "+format, v...)
+}
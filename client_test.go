@@ -0,0 +1,176 @@
+package tracker
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+// Authorization header value of test clients.
+const testToken = "OAuth y0_test-secret-token"
+
+// newTestClient
+// Create a client whose requests are served by handler instead of the Tracker API
+func newTestClient(t *testing.T, handler http.HandlerFunc) *TrackerClient {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	target, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := New(testToken, "1", "")
+	client.setTransport(testTransport{target: target})
+
+	return client
+}
+
+// testTransport sends requests to the test server, keeping their path and query.
+type testTransport struct {
+	target *url.URL
+}
+
+func (tr testTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = tr.target.Scheme
+	req.URL.Host = tr.target.Host
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func TestPatchTicketEmptyBody(t *testing.T) {
+	for _, status := range []int{http.StatusNoContent, http.StatusOK} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodPatch:
+					w.WriteHeader(status)
+				case http.MethodGet:
+					_, _ = w.Write([]byte(`{"key":"TEST-1","summary":"unchanged"}`))
+				}
+			})
+
+			ticket, err := client.PatchTicket("TEST-1", map[string]string{"summary": "unchanged"})
+			if err != nil {
+				t.Fatalf("PatchTicket: %v", err)
+			}
+			if ticket["summary"] != "unchanged" {
+				t.Errorf("ticket = %v, want the fetched ticket", ticket)
+			}
+		})
+	}
+}
+
+func TestDoEmptyBody(t *testing.T) {
+	for _, method := range []string{http.MethodDelete, http.MethodPatch} {
+		t.Run(method, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodDelete {
+					w.WriteHeader(http.StatusNoContent)
+				}
+			})
+
+			result := &Issue{Key: "TEST-1"}
+			resp, err := client.Do(client.NewRequest(method, "/v2/issues/TEST-1", nil), result)
+			if err != nil {
+				t.Fatalf("Do: %v", err)
+			}
+			if resp == nil || result.Key != "TEST-1" {
+				t.Errorf("resp = %v, result = %+v, want the result unchanged", resp, result)
+			}
+		})
+	}
+}
+
+func TestWithUseNumber(t *testing.T) {
+	const id = "9007199254740993"
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/issues/TEST-1":
+			_, _ = w.Write([]byte(`{"key":"TEST-1","queue":{"key":"TEST"},"externalId":` + id + `}`))
+		case "/v2/fields":
+			_, _ = w.Write([]byte(`[{"id":"externalId","schema":{"type":"integer"}}]`))
+		case "/v2/queues/TEST/fields":
+			_, _ = w.Write([]byte(`[]`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	client.WithUseNumber(true)
+
+	ticket, err := client.GetTicket("TEST-1")
+	if err != nil {
+		t.Fatalf("GetTicket: %v", err)
+	}
+	if number, ok := ticket["externalId"].(json.Number); !ok || number.String() != id {
+		t.Errorf("ticket externalId = %#v, want json.Number %s", ticket["externalId"], id)
+	}
+
+	issue, _, err := client.GetIssue("TEST-1")
+	if err != nil {
+		t.Fatalf("GetIssue: %v", err)
+	}
+	value, err := client.DecodeField(issue, "externalId")
+	if err != nil {
+		t.Fatalf("DecodeField: %v", err)
+	}
+	if number, ok := value.(json.Number); !ok || number.String() != id {
+		t.Errorf("DecodeField = %#v, want json.Number %s", value, id)
+	}
+}
+
+func TestWithLanguage(t *testing.T) {
+	displays := map[string]string{"en": "Open", "ru": "Открыт"}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		display, ok := displays[r.Header.Get("Accept-Language")]
+		if !ok {
+			display = "default"
+		}
+		switch r.Method + " " + r.URL.Path {
+		case "POST /v2/issues/_search":
+			_, _ = w.Write([]byte(`[{"key":"TEST-1","status":{"key":"open","display":"` + display + `"}}]`))
+		case "GET /v2/issues/TEST-1":
+			_, _ = w.Write([]byte(`{"key":"TEST-1","status":{"key":"open","display":"` + display + `"}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	search := func() string {
+		t.Helper()
+		issues, _, err := client.FindIssues(&FindIssuesOptions{Filter: map[string]interface{}{"queue": "TEST"}}, nil)
+		if err != nil || len(issues) != 1 {
+			t.Fatalf("FindIssues = %v, %v", issues, err)
+		}
+		return issues[0].Status.Display
+	}
+
+	client.WithLanguage("en")
+	if got := search(); got != "Open" {
+		t.Errorf("search display = %q, want Open", got)
+	}
+	issue, _, err := client.GetIssue("TEST-1")
+	if err != nil {
+		t.Fatalf("GetIssue: %v", err)
+	}
+	if issue.Status.Display != "Open" {
+		t.Errorf("issue display = %q, want Open", issue.Status.Display)
+	}
+
+	client.WithLanguage("ru")
+	if got := search(); got != "Открыт" {
+		t.Errorf("search display = %q, want Открыт", got)
+	}
+	client.WithLanguage("")
+	if got := search(); got != "default" {
+		t.Errorf("search display = %q, want no Accept-Language header", got)
+	}
+}
@@ -0,0 +1,97 @@
+package tracker
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Fields CloneIssue always copies from the source issue.
+var cloneFields = []string{"queue", "summary", "description", "type", "priority", "components", "tags"}
+
+type CloneIssueOptions struct {
+	// IDs of other fields to copy, e.g. local fields of the queue.
+	Fields []string
+
+	// Values set on the new issue instead of the copied ones.
+	Overrides *CreateIssueOptions
+
+	// Type of the link from the new issue to the source one, e.g. relates. Empty means no link.
+	LinkRelationship string
+}
+
+// CloneIssue
+// Create a copy of the issue with its queue, summary, description, type, priority, components, tags
+// and the fields listed in opts, then apply the overrides and link the copy to the source if asked.
+// If linking fails the created issue is returned together with the error.
+func (t *TrackerClient) CloneIssue(issueKey string, opts *CloneIssueOptions) (*Issue, error) {
+	if opts == nil {
+		opts = &CloneIssueOptions{}
+	}
+
+	raw, _, err := t.GetIssueRaw(issueKey)
+	if err != nil {
+		return nil, err
+	}
+	var source map[string]interface{}
+	if err := json.Unmarshal(raw, &source); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal: %w", err)
+	}
+
+	body := make(map[string]interface{})
+	for _, field := range append(cloneFields, opts.Fields...) {
+		if value, ok := source[field]; ok && value != nil {
+			body[field] = referenceValue(value)
+		}
+	}
+	if opts.Overrides != nil {
+		overrides, err := json.Marshal(opts.Overrides)
+		if err != nil {
+			return nil, fmt.Errorf("json.Marshal: %w", err)
+		}
+		if err := json.Unmarshal(overrides, &body); err != nil {
+			return nil, fmt.Errorf("json.Unmarshal: %w", err)
+		}
+	}
+
+	req := t.NewRequest(resty.MethodPost, "/v2/issues/", body)
+	if opts.Overrides != nil {
+		opts.Overrides.Notify.apply(req)
+	}
+	clone := new(Issue)
+	if _, err := t.Do(req, clone); err != nil {
+		return nil, fmt.Errorf("request: %w", err)
+	}
+
+	if opts.LinkRelationship != "" {
+		if _, _, err := t.LinkIssues(clone.Key, opts.LinkRelationship, issueKey); err != nil {
+			return clone, fmt.Errorf("link: %w", err)
+		}
+	}
+
+	return clone, nil
+}
+
+// referenceValue
+// Replace objects the API returns for references, e.g. a queue or a user, with their key or ID accepted on create
+func referenceValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		if key, ok := value["key"]; ok {
+			return key
+		}
+		if id, ok := value["id"]; ok {
+			return id
+		}
+		return value
+	case []interface{}:
+		result := make([]interface{}, len(value))
+		for i := range value {
+			result[i] = referenceValue(value[i])
+		}
+		return result
+	default:
+		return value
+	}
+}
@@ -0,0 +1,333 @@
+package tracker
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Maximum number of comments per page accepted by the API.
+const commentsMaxPerPage = 100
+
+// Comment structure in Yandex.Tracker
+// https://cloud.yandex.ru/en/docs/tracker/concepts/issues/get-comments
+type Comment struct {
+	// Address of the API resource with information about the comment.
+	Self string `json:"self"`
+
+	// Comment ID.
+	ID int64 `json:"id"`
+
+	// Comment ID in string format.
+	LongID string `json:"longId"`
+
+	// Comment text.
+	Text string `json:"text"`
+
+	// Object with information about the user who added the comment.
+	CreatedBy *BasicUser `json:"createdBy"`
+
+	// Object with information about the user who edited the comment last.
+	UpdatedBy *BasicUser `json:"updatedBy"`
+
+	// Comment creation date and time.
+	CreatedAt TrackerTime `json:"createdAt"`
+
+	// Date and time when the comment was last updated.
+	UpdatedAt TrackerTime `json:"updatedAt"`
+
+	// Array of objects with information about the users summoned in the comment.
+	Summonees []*BasicUser `json:"summonees"`
+
+	// Array of objects with information about the mailing lists summoned in the comment.
+	MaillistSummonees []*BasicUser `json:"maillistSummonees"`
+
+	// Array of objects with information about the comment attachments.
+	// Returned when the comments are requested with expand=attachments.
+	Attachments []*Attachment `json:"attachments"`
+
+	// Comment version. Each change to the comment increases its version number.
+	Version int `json:"version"`
+
+	// Comment type:
+	// standard: Comment sent via the Tracker interface.
+	// incoming: Incoming email message.
+	// outcoming: Outgoing email message.
+	Type string `json:"type"`
+
+	// Method of adding the comment:
+	// internal: Via the Tracker interface.
+	// email: Via email.
+	Transport string `json:"transport"`
+}
+
+type ListCommentsOptions struct {
+	// Additional fields to be included into the response:
+	// attachments: Attached files
+	// html: Comment HTML markup
+	// all: All additional fields
+	Expand string
+
+	// Number of comments per response page. The maximum value is 100.
+	PerPage int
+
+	// ID of the comment after which the requested page begins.
+	FromID int64
+}
+
+func (t *TrackerClient) GetComments(issueKey string, opts *ListCommentsOptions) ([]*Comment, *resty.Response, error) {
+	req := t.NewRequest(resty.MethodGet, "/v2/issues/"+issueKey+"/comments", nil)
+	if opts != nil {
+		if opts.Expand != "" {
+			req.SetQueryParam("expand", opts.Expand)
+		}
+		if opts.PerPage > 0 {
+			req.SetQueryParam("perPage", fmt.Sprint(opts.PerPage))
+		}
+		if opts.FromID > 0 {
+			req.SetQueryParam("id", strconv.FormatInt(opts.FromID, 10))
+		}
+	}
+	var result []*Comment
+	resp, err := t.Do(req, &result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+func (t *TrackerClient) GetComment(issueKey, commentID, expand string) (*Comment, *resty.Response, error) {
+	req := t.NewRequest(resty.MethodGet, "/v2/issues/"+issueKey+"/comments/"+commentID, nil)
+	if expand != "" {
+		req.SetQueryParam("expand", expand)
+	}
+	result := new(Comment)
+	resp, err := t.Do(req, result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+func (t *TrackerClient) GetCommentAttachments(issueKey, commentID string) ([]*Attachment, error) {
+	comment, _, err := t.GetComment(issueKey, commentID, "attachments")
+	if err != nil {
+		return nil, err
+	}
+
+	return comment.Attachments, nil
+}
+
+func (t *TrackerClient) GetAllComments(issueKey string) ([]*Comment, error) {
+	opts := &ListCommentsOptions{PerPage: commentsMaxPerPage}
+	var result []*Comment
+	for {
+		comments, resp, err := t.GetComments(issueKey, opts)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, comments...)
+		if !hasMore(resp, opts.PerPage, len(comments)) {
+			break
+		}
+		opts.FromID = comments[len(comments)-1].ID
+	}
+
+	return result, nil
+}
+
+// GetLatestComments
+// Get the last n issue comments, newest first.
+// The API only lists comments oldest first, so all pages are read while only the last n comments are kept.
+func (t *TrackerClient) GetLatestComments(issueKey string, n int) ([]*Comment, error) {
+	if n <= 0 {
+		return nil, &ValidationError{Field: "n", Reason: "must be positive"}
+	}
+
+	opts := &ListCommentsOptions{PerPage: commentsMaxPerPage}
+	var latest []*Comment
+	for {
+		comments, resp, err := t.GetComments(issueKey, opts)
+		if err != nil {
+			return nil, err
+		}
+		latest = append(latest, comments...)
+		if len(latest) > n {
+			latest = append(latest[:0:0], latest[len(latest)-n:]...)
+		}
+		if !hasMore(resp, opts.PerPage, len(comments)) {
+			break
+		}
+		opts.FromID = comments[len(comments)-1].ID
+	}
+
+	for i, j := 0, len(latest)-1; i < j; i, j = i+1, j-1 {
+		latest[i], latest[j] = latest[j], latest[i]
+	}
+
+	return latest, nil
+}
+
+func (t *TrackerClient) GetCommentsSince(issueKey string, since time.Time) ([]*Comment, error) {
+	comments, err := t.GetAllComments(issueKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*Comment
+	for _, comment := range comments {
+		if comment.changedSince(since) {
+			result = append(result, comment)
+		}
+	}
+
+	return result, nil
+}
+
+// changedSince
+// Report whether the comment was created or updated after the given moment
+func (c *Comment) changedSince(since time.Time) bool {
+	return c.CreatedAt.After(since) || c.UpdatedAt.After(since)
+}
+
+// Markup type of comment text in Yandex Flavored Markdown.
+const MarkupTypeMarkdown = "md"
+
+// https://cloud.yandex.ru/en/docs/tracker/concepts/issues/add-comment
+type AddCommentOptions struct {
+	// Comment text. Required.
+	Text *string `json:"text,omitempty"`
+
+	// Markup of the comment text. Set MarkupTypeMarkdown to render the text as Yandex Flavored Markdown,
+	// leave it empty to keep the default Tracker wiki markup.
+	MarkupType *string `json:"markupType,omitempty"`
+
+	// List of attachment IDs.
+	// Array of strings
+	AttachmentIDs *[]string `json:"attachmentIds,omitempty"`
+
+	// IDs or usernames of users to be summoned in the comment.
+	// Array of objects, numbers, or strings.
+	Summonees *[]interface{} `json:"summonees,omitempty"`
+
+	// Mailing lists to be summoned in the comment.
+	// Summoning a mailing list sends the comment outside of Tracker, leave it empty to keep the comment internal.
+	MaillistSummonees *[]string `json:"maillistSummonees,omitempty"`
+
+	// Notification settings passed as query parameters.
+	Notify *NotifyOptions `json:"-"`
+
+	// Files added with Attach, uploaded by AddComment.
+	files []commentFile
+}
+
+type commentFile struct {
+	name   string
+	reader io.Reader
+}
+
+// Attach
+// Add a file to upload with the comment, e.g. a screenshot referenced inline in its markdown.
+// AddComment uploads the files as temporary attachments and adds their IDs to AttachmentIDs
+func (o *AddCommentOptions) Attach(fileName string, r io.Reader) *AddCommentOptions {
+	o.files = append(o.files, commentFile{name: fileName, reader: r})
+	return o
+}
+
+// AddComment
+// Add a comment to the issue. Files added with Attach are uploaded first and returned in the Attachments
+// of the comment, so their content addresses are known without another request.
+func (t *TrackerClient) AddComment(issueKey string, opts *AddCommentOptions) (*Comment, *resty.Response, error) {
+	var uploaded []*Attachment
+	if opts != nil && len(opts.files) > 0 {
+		withFiles := *opts
+		var ids []string
+		if opts.AttachmentIDs != nil {
+			ids = append(ids, *opts.AttachmentIDs...)
+		}
+		for _, file := range opts.files {
+			attachment, _, err := t.UploadTemporaryAttachment(file.name, file.reader)
+			if err != nil {
+				return nil, nil, fmt.Errorf("upload %s: %w", file.name, err)
+			}
+			uploaded = append(uploaded, attachment)
+			ids = append(ids, attachment.ID)
+		}
+		withFiles.AttachmentIDs = &ids
+		opts = &withFiles
+	}
+
+	req := t.NewRequest(resty.MethodPost, "/v2/issues/"+issueKey+"/comments", opts)
+	if opts != nil {
+		opts.Notify.apply(req)
+	}
+	result := new(Comment)
+	resp, err := t.Do(req, result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+	if len(uploaded) > 0 && len(result.Attachments) == 0 {
+		result.Attachments = uploaded
+	}
+
+	return result, resp, nil
+}
+
+// IsInternal
+// Report whether the comment was added via the Tracker interface rather than sent or received by email
+func (c *Comment) IsInternal() bool {
+	return c.Transport != "email"
+}
+
+// CommentWithAuthor is a comment with the full user information of its author.
+type CommentWithAuthor struct {
+	*Comment
+
+	// User who added the comment.
+	Author *User
+}
+
+// HydrateCommentAuthors
+// Resolve comment authors into full users, fetching every distinct author once
+func (t *TrackerClient) HydrateCommentAuthors(comments []*Comment) ([]*CommentWithAuthor, error) {
+	authors := make(map[string]*User)
+	result := make([]*CommentWithAuthor, len(comments))
+	for i, comment := range comments {
+		result[i] = &CommentWithAuthor{Comment: comment}
+		id := comment.CreatedBy.Id()
+		if id == "" {
+			continue
+		}
+
+		author, ok := authors[id]
+		if !ok {
+			user, _, err := t.GetUser(id)
+			if err != nil {
+				return nil, fmt.Errorf("get user %s: %w", id, err)
+			}
+			author = user
+			authors[id] = author
+		}
+		result[i].Author = author
+	}
+
+	return result, nil
+}
+
+// CommentCount
+// Get the number of issue comments from the issue counters without fetching the comments
+func (t *TrackerClient) CommentCount(issueKey string) (int, error) {
+	issue, _, err := t.GetIssueFields(issueKey, []string{
+		"commentWithoutExternalMessageCount", "commentWithExternalMessageCount",
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return issue.CommentWithoutExternalMessageCount + issue.CommentWithExternalMessageCount, nil
+}
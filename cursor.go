@@ -0,0 +1,100 @@
+package tracker
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Cursor is the position of the next page of search results. It can be stored with MarshalText
+// and restored with UnmarshalText to resume a search after a restart.
+// Cursors of scroll searches are only valid for the scroll TTL.
+type Cursor struct {
+	page        int
+	scrollID    string
+	scrollToken string
+}
+
+type cursorText struct {
+	Page        int    `json:"p,omitempty"`
+	ScrollID    string `json:"s,omitempty"`
+	ScrollToken string `json:"t,omitempty"`
+}
+
+func (c Cursor) MarshalText() ([]byte, error) {
+	data, err := json.Marshal(cursorText{Page: c.page, ScrollID: c.scrollID, ScrollToken: c.scrollToken})
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(base64.RawURLEncoding.EncodeToString(data)), nil
+}
+
+func (c *Cursor) UnmarshalText(text []byte) error {
+	data, err := base64.RawURLEncoding.DecodeString(string(text))
+	if err != nil {
+		return fmt.Errorf("invalid cursor: %w", err)
+	}
+	var decoded cursorText
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return fmt.Errorf("invalid cursor: %w", err)
+	}
+	c.page, c.scrollID, c.scrollToken = decoded.Page, decoded.ScrollID, decoded.ScrollToken
+
+	return nil
+}
+
+// FindIssuesCursor
+// Get the page of issues matching the search at cursor, the first page if cursor is nil, with perPage issues per page.
+// Returns the cursor of the next page, nil after the last one.
+func (t *TrackerClient) FindIssuesCursor(
+	opts *FindIssuesOptions, cursor *Cursor, perPage int,
+) ([]*Issue, *Cursor, error) {
+	listOpts := &ListOptions{PerPage: perPage, Page: 1}
+	if cursor != nil && cursor.page > 0 {
+		listOpts.Page = cursor.page
+	}
+
+	issues, resp, err := t.FindIssues(opts, listOpts)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !hasNextPage(resp, listOpts.Page, listOpts.PerPage, len(issues)) {
+		return issues, nil, nil
+	}
+
+	return issues, &Cursor{page: listOpts.Page + 1}, nil
+}
+
+// FindIssuesScrollCursor
+// Get the scroll page of issues matching the search at cursor, starting a new scroll tuned with scrollOpts if cursor is nil.
+// Returns the cursor of the next scroll page, nil after the last one.
+func (t *TrackerClient) FindIssuesScrollCursor(
+	opts *FindIssuesOptions, scrollOpts *ScrollOptions, cursor *Cursor,
+) ([]*Issue, *Cursor, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	req := t.NewRequest(resty.MethodPost, "/v2/issues/_search", opts)
+	if cursor == nil || cursor.scrollID == "" {
+		t.applyScroll(scrollOpts, req)
+	} else {
+		req.SetQueryParam("scrollId", cursor.scrollID)
+		req.SetQueryParam("scrollToken", cursor.scrollToken)
+	}
+	var issues []*Issue
+	resp, err := t.Do(req, &issues)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	scrollID := resp.Header().Get("X-Scroll-Id")
+	if len(issues) == 0 || scrollID == "" {
+		return issues, nil, nil
+	}
+
+	return issues, &Cursor{scrollID: scrollID, scrollToken: resp.Header().Get("X-Scroll-Token")}, nil
+}
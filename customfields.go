@@ -0,0 +1,168 @@
+package tracker
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+)
+
+var (
+	fieldDecodersMu sync.RWMutex
+	fieldDecoders   = make(map[string]func(json.RawMessage) (interface{}, error))
+)
+
+// RegisterFieldDecoder
+// Decode the field with fieldKey with fn whenever an issue is decoded, storing the result in Issue.Decoded.
+// Decoders are shared by all clients; register them once at startup. Null values are not passed to fn,
+// and an error returned by fn is stored in Issue.DecodeErrors without failing the decoding of the issue.
+func RegisterFieldDecoder(fieldKey string, fn func(json.RawMessage) (interface{}, error)) {
+	fieldDecodersMu.Lock()
+	defer fieldDecodersMu.Unlock()
+
+	fieldDecoders[fieldKey] = fn
+}
+
+// UnmarshalJSON
+// Decode the issue keeping every field of it in Fields and decoding fields with a registered decoder into Decoded
+func (i *Issue) UnmarshalJSON(data []byte) error {
+	type issue Issue
+	if err := json.Unmarshal(data, (*issue)(i)); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, &i.Fields); err != nil {
+		return err
+	}
+
+	fieldDecodersMu.RLock()
+	defer fieldDecodersMu.RUnlock()
+	i.Decoded, i.DecodeErrors = nil, nil
+	for fieldKey, decode := range fieldDecoders {
+		raw, ok := i.Fields[fieldKey]
+		if !ok || string(raw) == "null" {
+			continue
+		}
+		value, err := decode(raw)
+		if err != nil {
+			if i.DecodeErrors == nil {
+				i.DecodeErrors = make(map[string]error)
+			}
+			i.DecodeErrors[fieldKey] = fmt.Errorf("field %s: %w", fieldKey, err)
+			continue
+		}
+		if i.Decoded == nil {
+			i.Decoded = make(map[string]interface{})
+		}
+		i.Decoded[fieldKey] = value
+	}
+
+	return nil
+}
+
+// GetDecoded
+// Get the value of a field decoded by its registered decoder. Reports false if the field is missing,
+// null or has no decoder
+func (i *Issue) GetDecoded(fieldKey string) (interface{}, bool) {
+	value, ok := i.Decoded[fieldKey]
+	return value, ok
+}
+
+// HasField
+// Report whether the API returned the field, even as null. With a nil pointer field such as Assignee
+// it tells an unassigned issue (true) from an issue fetched without the field (false)
+func (i *Issue) HasField(fieldKey string) bool {
+	_, ok := i.Fields[fieldKey]
+	return ok
+}
+
+// IsNullField
+// Report whether the API returned the field as null
+func (i *Issue) IsNullField(fieldKey string) bool {
+	raw, ok := i.Fields[fieldKey]
+	return ok && string(bytes.TrimSpace(raw)) == "null"
+}
+
+// GetString
+// Get a string field. Reports false if the field is missing, null or not a string
+func (i *Issue) GetString(fieldKey string) (string, bool) {
+	var value *string
+	if !i.decodeField(fieldKey, &value) || value == nil {
+		return "", false
+	}
+
+	return *value, true
+}
+
+// GetFloat
+// Get a number field. Reports false if the field is missing, null or not a number
+func (i *Issue) GetFloat(fieldKey string) (float64, bool) {
+	var value *float64
+	if !i.decodeField(fieldKey, &value) || value == nil {
+		return 0, false
+	}
+
+	return *value, true
+}
+
+// GetNumber
+// Get a number field exactly as returned by the API, e.g. a 64-bit ID that does not fit a float64.
+// Reports false if the field is missing, null or not a number
+func (i *Issue) GetNumber(fieldKey string) (json.Number, bool) {
+	raw, ok := i.Fields[fieldKey]
+	if !ok {
+		return "", false
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var value interface{}
+	if err := dec.Decode(&value); err != nil {
+		return "", false
+	}
+	number, ok := value.(json.Number)
+
+	return number, ok
+}
+
+// GetStringSlice
+// Get a field holding strings. A single string is returned as a slice of one.
+// Reports false if the field is missing, null or holds anything but strings
+func (i *Issue) GetStringSlice(fieldKey string) ([]string, bool) {
+	var value StringList
+	if !i.decodeField(fieldKey, &value) || value == nil {
+		return nil, false
+	}
+
+	return value, true
+}
+
+// GetUserField
+// Get a user field. Reports false if the field is missing, null or not a user object
+func (i *Issue) GetUserField(fieldKey string) (*BasicUser, bool) {
+	var value *BasicUser
+	if !i.decodeField(fieldKey, &value) || value == nil {
+		return nil, false
+	}
+
+	return value, true
+}
+
+// GetTime
+// Get a date or date and time field. Reports false if the field is missing, null or not a date
+func (i *Issue) GetTime(fieldKey string) (time.Time, bool) {
+	var value TrackerTime
+	if !i.decodeField(fieldKey, &value) || value.IsZero() {
+		return time.Time{}, false
+	}
+
+	return value.Time, true
+}
+
+func (i *Issue) decodeField(fieldKey string, v interface{}) bool {
+	raw, ok := i.Fields[fieldKey]
+	if !ok {
+		return false
+	}
+
+	return json.Unmarshal(raw, v) == nil
+}
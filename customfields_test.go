@@ -0,0 +1,97 @@
+package tracker
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestIssueFieldDecoderErrors(t *testing.T) {
+	errBad := errors.New("bad value")
+	RegisterFieldDecoder("testGood", func(raw json.RawMessage) (interface{}, error) {
+		return string(raw), nil
+	})
+	RegisterFieldDecoder("testBad", func(json.RawMessage) (interface{}, error) {
+		return nil, errBad
+	})
+	t.Cleanup(func() {
+		fieldDecodersMu.Lock()
+		defer fieldDecodersMu.Unlock()
+		delete(fieldDecoders, "testGood")
+		delete(fieldDecoders, "testBad")
+	})
+
+	var issue Issue
+	if err := json.Unmarshal([]byte(`{"key":"TEST-1","testGood":1,"testBad":2}`), &issue); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if issue.Key != "TEST-1" {
+		t.Errorf("key = %q", issue.Key)
+	}
+	if value, ok := issue.GetDecoded("testGood"); !ok || value != "1" {
+		t.Errorf("testGood = %v, %v", value, ok)
+	}
+	if _, ok := issue.GetDecoded("testBad"); ok {
+		t.Error("testBad decoded despite the error")
+	}
+	if err := issue.DecodeErrors["testBad"]; !errors.Is(err, errBad) {
+		t.Errorf("DecodeErrors[testBad] = %v", err)
+	}
+}
+
+func TestIssueGetNumber(t *testing.T) {
+	var issue Issue
+	if err := json.Unmarshal([]byte(`{"id64":9007199254740993,"text":"1","empty":null}`), &issue); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if number, ok := issue.GetNumber("id64"); !ok || number != "9007199254740993" {
+		t.Errorf("id64 = %v, %v", number, ok)
+	}
+	for _, fieldKey := range []string{"text", "empty", "missing"} {
+		if number, ok := issue.GetNumber(fieldKey); ok {
+			t.Errorf("%s = %v, want not a number", fieldKey, number)
+		}
+	}
+}
+
+func TestIssueHasFieldIsNullField(t *testing.T) {
+	tests := []struct {
+		data     string
+		wantHas  bool
+		wantNull bool
+	}{
+		{data: `{}`, wantHas: false, wantNull: false},
+		{data: `{"x":null}`, wantHas: true, wantNull: true},
+		{data: `{"x":1}`, wantHas: true, wantNull: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.data, func(t *testing.T) {
+			var issue Issue
+			if err := json.Unmarshal([]byte(tt.data), &issue); err != nil {
+				t.Fatalf("Unmarshal: %v", err)
+			}
+			if got := issue.HasField("x"); got != tt.wantHas {
+				t.Errorf("HasField = %v, want %v", got, tt.wantHas)
+			}
+			if got := issue.IsNullField("x"); got != tt.wantNull {
+				t.Errorf("IsNullField = %v, want %v", got, tt.wantNull)
+			}
+		})
+	}
+}
+
+func TestIssueNullAssignee(t *testing.T) {
+	var unassigned, notFetched Issue
+	if err := json.Unmarshal([]byte(`{"assignee":null,"resolution":null}`), &unassigned); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(`{}`), &notFetched); err != nil {
+		t.Fatal(err)
+	}
+	if unassigned.Assignee != nil || unassigned.Resolution != nil || notFetched.Assignee != nil {
+		t.Errorf("null and missing references must decode as nil")
+	}
+	if !unassigned.HasField("assignee") || notFetched.HasField("assignee") {
+		t.Errorf("HasField must tell an unassigned issue from one fetched without the assignee")
+	}
+}
@@ -0,0 +1,139 @@
+package tracker
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Issue fields that change with every update and are left out by DiffIssues.
+var diffIgnoredFields = map[string]bool{
+	"self":      true,
+	"version":   true,
+	"updatedAt": true,
+	"updatedBy": true,
+}
+
+// FieldChange is a field that differs between two snapshots of an issue, or a change of the field
+// in the issue history.
+type FieldChange struct {
+	// Field ID, e.g. assignee.
+	Field string
+
+	// Decoded JSON value in the old snapshot, nil if the field was missing or null.
+	Old interface{}
+
+	// Decoded JSON value in the new snapshot, nil if the field is missing or null.
+	New interface{}
+
+	// Date and time of the change. Only set by FieldHistory.
+	UpdatedAt TrackerTime
+
+	// Object with information about the user who made the change. Only set by FieldHistory.
+	UpdatedBy *BasicUser
+}
+
+// DiffIssues
+// Get the fields that differ between two snapshots of an issue, sorted by field ID.
+// References such as the status or the assignee are compared by key or ID, so a changed display name
+// is not a change, and multi-value fields are compared regardless of the order of their values.
+// The version and the last update fields are left out.
+func DiffIssues(oldIssue, newIssue *Issue) ([]*FieldChange, error) {
+	oldFields, err := issueFieldValues(oldIssue)
+	if err != nil {
+		return nil, fmt.Errorf("old issue: %w", err)
+	}
+	newFields, err := issueFieldValues(newIssue)
+	if err != nil {
+		return nil, fmt.Errorf("new issue: %w", err)
+	}
+
+	keys := make(map[string]bool, len(oldFields)+len(newFields))
+	for key := range oldFields {
+		keys[key] = true
+	}
+	for key := range newFields {
+		keys[key] = true
+	}
+
+	var result []*FieldChange
+	for key := range keys {
+		if diffIgnoredFields[key] {
+			continue
+		}
+		oldValue, newValue := oldFields[key], newFields[key]
+		if !reflect.DeepEqual(comparableValue(oldValue), comparableValue(newValue)) {
+			result = append(result, &FieldChange{Field: key, Old: oldValue, New: newValue})
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Field < result[j].Field
+	})
+
+	return result, nil
+}
+
+// issueFieldValues
+// Decode every field of the issue. Issues decoded from the API keep the fields as returned,
+// others are encoded first, which covers only the fields of the Issue struct
+func issueFieldValues(issue *Issue) (map[string]interface{}, error) {
+	if issue == nil {
+		return nil, nil
+	}
+
+	raw := issue.Fields
+	if raw == nil {
+		data, err := json.Marshal(issue)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return nil, err
+		}
+	}
+
+	result := make(map[string]interface{}, len(raw))
+	for key, value := range raw {
+		var decoded interface{}
+		if err := json.Unmarshal(value, &decoded); err != nil {
+			return nil, fmt.Errorf("field %s: %w", key, err)
+		}
+		if decoded != nil {
+			result[key] = decoded
+		}
+	}
+
+	return result, nil
+}
+
+// comparableValue
+// Reduce references to their key or ID and sort multiple values, leaving other values as is.
+// Empty arrays are the same as missing values
+func comparableValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for _, id := range []string{"key", "id"} {
+			if ref, ok := value[id]; ok {
+				return fmt.Sprint(ref)
+			}
+		}
+		result := make(map[string]interface{}, len(value))
+		for key, item := range value {
+			result[key] = comparableValue(item)
+		}
+		return result
+	case []interface{}:
+		if len(value) == 0 {
+			return nil
+		}
+		items := make([]string, len(value))
+		for i, item := range value {
+			items[i] = fmt.Sprint(comparableValue(item))
+		}
+		sort.Strings(items)
+		return items
+	default:
+		return value
+	}
+}
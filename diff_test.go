@@ -0,0 +1,73 @@
+package tracker
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestDiffIssues(t *testing.T) {
+	snapshots := map[string]string{
+		"old": `{"key":"TEST-1","version":3,"updatedAt":"2024-01-01T00:00:00.000+0000","summary":"Old",
+			"status":{"key":"open","display":"Open"},"assignee":{"id":"1","display":"Alice"},
+			"tags":["a","b"],"followers":[],"storyPoints":3}`,
+		"new": `{"key":"TEST-1","version":4,"updatedAt":"2024-01-02T00:00:00.000+0000","summary":"New",
+			"status":{"key":"open","display":"Открыт"},"assignee":{"id":"2","display":"Bob"},
+			"tags":["b","a"],"storyPoints":null,"team":"backend"}`,
+	}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		version := r.URL.Query().Get("snapshot")
+		if r.Method != http.MethodGet || r.URL.Path != "/v2/issues/TEST-1" || snapshots[version] == "" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		_, _ = w.Write([]byte(snapshots[version]))
+	})
+	get := func(snapshot string) *Issue {
+		issue := new(Issue)
+		req := client.NewRequest(http.MethodGet, "/v2/issues/TEST-1", nil).SetQueryParam("snapshot", snapshot)
+		if _, err := client.Do(req, issue); err != nil {
+			t.Fatalf("Do: %v", err)
+		}
+		return issue
+	}
+
+	changes, err := DiffIssues(get("old"), get("new"))
+	if err != nil {
+		t.Fatalf("DiffIssues: %v", err)
+	}
+	var fields []string
+	for _, change := range changes {
+		fields = append(fields, change.Field)
+	}
+	if want := []string{"assignee", "storyPoints", "summary", "team"}; !reflect.DeepEqual(fields, want) {
+		t.Fatalf("changed fields = %v, want %v", fields, want)
+	}
+	if changes[1].Old != float64(3) || changes[1].New != nil {
+		t.Errorf("storyPoints = %v -> %v", changes[1].Old, changes[1].New)
+	}
+	if changes[2].Old != "Old" || changes[2].New != "New" {
+		t.Errorf("summary = %v -> %v", changes[2].Old, changes[2].New)
+	}
+	if changes[3].Old != nil || changes[3].New != "backend" {
+		t.Errorf("team = %v -> %v", changes[3].Old, changes[3].New)
+	}
+}
+
+func TestDiffIssuesStructs(t *testing.T) {
+	oldIssue := &Issue{Key: "TEST-1", Summary: "s", Tags: []string{"a"}}
+	newIssue := &Issue{Key: "TEST-1", Summary: "s", Tags: []string{"a", "b"}}
+
+	changes, err := DiffIssues(oldIssue, newIssue)
+	if err != nil {
+		t.Fatalf("DiffIssues: %v", err)
+	}
+	if len(changes) != 1 || changes[0].Field != "tags" {
+		data, _ := json.Marshal(changes)
+		t.Fatalf("changes = %s, want only tags", data)
+	}
+
+	if changes, err := DiffIssues(newIssue, newIssue); err != nil || len(changes) != 0 {
+		t.Errorf("DiffIssues of the same issue = %v, %v", changes, err)
+	}
+}
@@ -0,0 +1,62 @@
+package tracker
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Header set on synthesized responses returned in dry-run mode.
+const dryRunHeader = "X-Tracker-Dry-Run"
+
+// Path suffixes of POST endpoints that only read data.
+var readOnlyPostSuffixes = []string{"/_search", "/_count"}
+
+// WithDryRun
+// When enabled, mutating requests are logged instead of sent and a synthesized
+// successful response marked with IsDryRun is returned. Read requests are sent as usual.
+func (t *TrackerClient) WithDryRun(d bool) {
+	t.dryRun = d
+}
+
+// IsDryRun
+// Report whether the response was synthesized in dry-run mode
+func IsDryRun(resp *resty.Response) bool {
+	return resp != nil && resp.Header().Get(dryRunHeader) != ""
+}
+
+func (t *TrackerClient) dryRunResponse(req *resty.Request) *resty.Response {
+	body := ""
+	if req.Body != nil {
+		if b, err := json.Marshal(req.Body); err == nil {
+			body = string(b)
+		}
+	}
+	t.logger.Warnf("dry run: %s %s body=%s", req.Method, req.URL, body)
+
+	return &resty.Response{
+		Request: req,
+		RawResponse: &http.Response{
+			Status:     http.StatusText(http.StatusOK),
+			StatusCode: http.StatusOK,
+			Header:     http.Header{dryRunHeader: []string{"true"}},
+		},
+	}
+}
+
+func isMutation(req *resty.Request) bool {
+	switch req.Method {
+	case resty.MethodGet, resty.MethodHead, resty.MethodOptions:
+		return false
+	case resty.MethodPost:
+		for _, suffix := range readOnlyPostSuffixes {
+			if strings.HasSuffix(req.URL, suffix) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
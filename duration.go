@@ -0,0 +1,161 @@
+package tracker
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Working time units used by Yandex.Tracker durations.
+const (
+	workDay  = 8 * time.Hour
+	workWeek = 5 * workDay
+)
+
+// TrackerDuration is an ISO 8601 duration as used by Yandex.Tracker, e.g. P1W2DT3H30M.
+// Tracker counts in working time, so a day is 8 hours and a week is 5 days.
+type TrackerDuration struct {
+	time.Duration
+}
+
+// ParseTrackerDuration
+// Parse an ISO 8601 duration with weeks, days, hours, minutes and seconds
+func ParseTrackerDuration(s string) (time.Duration, error) {
+	rest, ok := strings.CutPrefix(s, "P")
+	if !ok || rest == "" {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+
+	var result time.Duration
+	inTime, hasTime := false, false
+	for rest != "" {
+		if rest[0] == 'T' {
+			if inTime {
+				return 0, fmt.Errorf("invalid duration %q", s)
+			}
+			inTime = true
+			rest = rest[1:]
+			continue
+		}
+
+		i := strings.IndexAny(rest, "WDHMS")
+		if i <= 0 || !isDecimal(rest[:i]) {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+		value, err := strconv.ParseFloat(strings.Replace(rest[:i], ",", ".", 1), 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q: %w", s, err)
+		}
+
+		var unit time.Duration
+		switch {
+		case rest[i] == 'W' && !inTime:
+			unit = workWeek
+		case rest[i] == 'D' && !inTime:
+			unit = workDay
+		case rest[i] == 'H' && inTime:
+			unit = time.Hour
+		case rest[i] == 'M' && inTime:
+			unit = time.Minute
+		case rest[i] == 'S' && inTime:
+			unit = time.Second
+		default:
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+		result += time.Duration(value * float64(unit))
+		hasTime = hasTime || inTime
+		rest = rest[i+1:]
+	}
+	if inTime && !hasTime {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+
+	return result, nil
+}
+
+// isDecimal
+// Check that s is a plain decimal number with an optional fraction after a dot or a comma, e.g. 1.5 or 0,25
+func isDecimal(s string) bool {
+	whole, fraction, hasFraction := strings.Cut(strings.Replace(s, ",", ".", 1), ".")
+	if whole == "" || hasFraction && fraction == "" {
+		return false
+	}
+	for _, c := range whole + fraction {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// String
+// Format the duration in ISO 8601 working time notation
+func (d TrackerDuration) String() string {
+	rest := d.Duration
+	if rest < 0 {
+		rest = -rest
+	}
+
+	var b strings.Builder
+	b.WriteString("P")
+	for _, unit := range []struct {
+		size   time.Duration
+		suffix string
+	}{{workWeek, "W"}, {workDay, "D"}} {
+		if n := rest / unit.size; n > 0 {
+			b.WriteString(strconv.FormatInt(int64(n), 10) + unit.suffix)
+			rest -= n * unit.size
+		}
+	}
+	if rest > 0 || b.Len() == 1 {
+		b.WriteString("T")
+		for _, unit := range []struct {
+			size   time.Duration
+			suffix string
+		}{{time.Hour, "H"}, {time.Minute, "M"}} {
+			if n := rest / unit.size; n > 0 {
+				b.WriteString(strconv.FormatInt(int64(n), 10) + unit.suffix)
+				rest -= n * unit.size
+			}
+		}
+		if rest > 0 || b.String() == "PT" {
+			b.WriteString(strconv.FormatFloat(rest.Seconds(), 'f', -1, 64) + "S")
+		}
+	}
+
+	if d.Duration < 0 {
+		return "-" + b.String()
+	}
+
+	return b.String()
+}
+
+func (d TrackerDuration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+func (d *TrackerDuration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		d.Duration = 0
+		return nil
+	}
+
+	negative := strings.HasPrefix(s, "-")
+	parsed, err := ParseTrackerDuration(strings.TrimPrefix(s, "-"))
+	if err != nil {
+		return err
+	}
+	if negative {
+		parsed = -parsed
+	}
+	d.Duration = parsed
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package tracker
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Number of levels below the epic fetched by GetEpicTree.
+const epicTreeMaxDepth = 5
+
+// IssueTree is an issue with the issues below it, e.g. an epic with its stories and their subtasks.
+type IssueTree struct {
+	// Issue at this node.
+	Issue *Issue
+
+	// Issues having this one as the parent, or the epic for the first level.
+	Children []*IssueTree
+}
+
+// GetEpicTree
+// Get the epic with the issues below it: issues linked to the epic or having it as the parent,
+// then their subtasks level by level, at most 5 levels deep. Issues of one level are fetched concurrently.
+// An issue is placed in the tree only once, so parent cycles do not loop.
+func (t *TrackerClient) GetEpicTree(epicKey string) (*IssueTree, error) {
+	epic, _, err := t.GetIssue(epicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	root := &IssueTree{Issue: epic}
+	seen := map[string]bool{epic.Key: true}
+	level := []*IssueTree{root}
+	for depth := 0; depth < epicTreeMaxDepth && len(level) > 0; depth++ {
+		nodes := make(map[string]*IssueTree, len(level))
+		keys := make([]string, len(level))
+		for i, node := range level {
+			nodes[node.Issue.Key] = node
+			keys[i] = node.Issue.Key
+		}
+
+		var mu sync.Mutex
+		children := make(map[string][]*Issue, len(keys))
+		err := t.BatchExecute(context.Background(), keys, func(ctx context.Context, key string) error {
+			query := fmt.Sprintf(`Parent: %s`, quoteQueryValue(key))
+			if depth == 0 {
+				query = fmt.Sprintf(`Epic: %[1]s OR Parent: %[1]s`, quoteQueryValue(key))
+			}
+			issues, err := t.FindAllIssues(&FindIssuesOptions{Query: &query})
+			if err != nil {
+				return err
+			}
+			mu.Lock()
+			children[key] = issues
+			mu.Unlock()
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var next []*IssueTree
+		for _, key := range keys {
+			for _, child := range children[key] {
+				if seen[child.Key] {
+					continue
+				}
+				seen[child.Key] = true
+				node := &IssueTree{Issue: child}
+				nodes[key].Children = append(nodes[key].Children, node)
+				next = append(next, node)
+			}
+		}
+		level = next
+	}
+
+	return root, nil
+}
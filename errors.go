@@ -0,0 +1,191 @@
+package tracker
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-resty/resty/v2"
+)
+
+var (
+	// ErrNotFound is returned when the requested object does not exist.
+	// Check for it with errors.Is.
+	ErrNotFound = errors.New("not found")
+
+	// ErrUnauthorized is returned on 401 responses: the token is missing, invalid or expired.
+	ErrUnauthorized = errors.New("unauthorized")
+
+	// ErrForbidden is returned on 403 responses: the user has no permission for the action.
+	ErrForbidden = errors.New("forbidden")
+
+	// ErrConflict is returned when the request conflicts with an existing object,
+	// e.g. an issue with the same unique value already exists.
+	ErrConflict = errors.New("conflict")
+
+	// ErrResponseTooLarge is returned when a response body exceeds the limit set with WithMaxResponseBytes.
+	ErrResponseTooLarge = resty.ErrResponseBodyTooLarge
+)
+
+// APIError is returned when Tracker responds with an error status code.
+// With errors.Is it matches ErrUnauthorized on 401, ErrForbidden on 403,
+// ErrNotFound on 404 and ErrConflict on 409.
+type APIError struct {
+	// HTTP status code of the response.
+	StatusCode int
+
+	// Raw response body, usually a JSON object with errorMessages.
+	Body string
+
+	// Error messages parsed from the response body.
+	Messages []string
+
+	// Errors of single fields parsed from the response body, keyed by field ID.
+	FieldErrors map[string]string
+
+	// ID of the request from the X-Request-Id response header, empty if the API did not return it.
+	// Quote it when reporting the failure to Yandex support.
+	RequestID string
+
+	message string
+}
+
+// Number of body bytes included in APIError messages by default.
+const defaultErrorBodyLimit = 512
+
+// newAPIError
+// Build the error of a response with an error status code. The message includes the body
+// cut to the limit set with WithErrorBodyLimit and never the request headers, which hold the token.
+func (t *TrackerClient) newAPIError(statusCode int, header http.Header, body []byte) *APIError {
+	var parsed struct {
+		ErrorMessages []string          `json:"errorMessages"`
+		Errors        map[string]string `json:"errors"`
+	}
+	_ = json.Unmarshal(body, &parsed)
+
+	messages := parsed.ErrorMessages
+	for field, text := range parsed.Errors {
+		messages = append(messages, field+": "+text)
+	}
+
+	requestID := header.Get(requestIDHeader)
+	message := fmt.Sprintf("wrong status code: %d", statusCode)
+	if requestID != "" {
+		message += ", request id=" + requestID
+	}
+	if t.errorBodyLimit > 0 {
+		message += ", message=" + truncateBody(body, t.errorBodyLimit)
+	}
+
+	return &APIError{
+		StatusCode:  statusCode,
+		Body:        string(body),
+		Messages:    messages,
+		FieldErrors: parsed.Errors,
+		RequestID:   requestID,
+		message:     message,
+	}
+}
+
+// truncateBody
+// Cut the body to at most limit bytes without splitting a UTF-8 character
+func truncateBody(body []byte, limit int) string {
+	if len(body) <= limit {
+		return string(body)
+	}
+	cut := limit
+	for cut > 0 && !utf8.RuneStart(body[cut]) {
+		cut--
+	}
+
+	return string(body[:cut]) + fmt.Sprintf("... (%d bytes truncated)", len(body)-cut)
+}
+
+// WithErrorBodyLimit
+// Set how many bytes of the response body are included in APIError messages; 0 leaves the body out.
+// The full body is always available in APIError.Body
+func (t *TrackerClient) WithErrorBodyLimit(n int) {
+	t.errorBodyLimit = n
+}
+
+func (e *APIError) Error() string {
+	return e.message
+}
+
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	default:
+		return false
+	}
+}
+
+// ValidationError is returned when options fail client-side validation before a request is sent.
+type ValidationError struct {
+	// Name of the invalid option.
+	Field string
+
+	// What is wrong with the option.
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid %s: %s", e.Field, e.Reason)
+}
+
+// ValidationErrors is returned when several options fail client-side validation.
+type ValidationErrors []*ValidationError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+func (e ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+
+	return errs
+}
+
+// MissingFieldsError is returned when an issue change is rejected because fields it requires are not set.
+type MissingFieldsError struct {
+	// IDs of the fields to set, sorted.
+	Fields []string
+
+	// Error returned by the API, nil if the fields were found missing before a request was sent.
+	Err *APIError
+}
+
+func (e *MissingFieldsError) Error() string {
+	message := "missing required fields " + strings.Join(e.Fields, ", ")
+	if e.Err != nil {
+		message += ": " + e.Err.Error()
+	}
+
+	return message
+}
+
+func (e *MissingFieldsError) Unwrap() error {
+	if e.Err == nil {
+		return nil
+	}
+
+	return e.Err
+}
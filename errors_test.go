@@ -0,0 +1,53 @@
+package tracker
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestAPIErrorDoesNotLeakToken(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != testToken {
+			t.Errorf("Authorization = %q", r.Header.Get("Authorization"))
+		}
+		w.Header().Set("X-Request-Id", "req-1")
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"errorMessages":["no access"],"errors":{"queue":"is closed"}}`))
+	})
+
+	_, _, issueErr := client.GetIssue("TEST-1")
+	_, ticketErr := client.GetTicket("TEST-1")
+	_, patchErr := client.PatchTicket("TEST-1", map[string]string{"summary": "s"})
+	secret := strings.TrimPrefix(testToken, "OAuth ")
+	for name, err := range map[string]error{"GetIssue": issueErr, "GetTicket": ticketErr, "PatchTicket": patchErr} {
+		if !errors.Is(err, ErrForbidden) {
+			t.Errorf("%s: err = %v, want ErrForbidden", name, err)
+			continue
+		}
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || apiErr.RequestID != "req-1" {
+			t.Errorf("%s: err = %#v, want APIError with the request id", name, err)
+		}
+		for _, text := range []string{err.Error(), fmt.Sprint(err), fmt.Sprintf("%+v", err), fmt.Sprintf("%#v", err)} {
+			if strings.Contains(text, secret) {
+				t.Errorf("%s: error text contains the token: %s", name, text)
+			}
+		}
+	}
+}
+
+func TestAPIErrorBodyLimit(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(strings.Repeat("x", 100)))
+	})
+	client.WithErrorBodyLimit(10)
+
+	_, _, err := client.GetIssue("TEST-1")
+	if err == nil || !strings.Contains(err.Error(), "xxxxxxxxxx... (90 bytes truncated)") {
+		t.Errorf("err = %v, want the body cut to 10 bytes", err)
+	}
+}
@@ -0,0 +1,135 @@
+package tracker
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Number of issues requested per page by the export helpers.
+const exportPerPage = 100
+
+// ExportIssuesCSV
+// Write issues matching opts as CSV rows with a header row of fields.
+// Fields are dotted paths into the issue JSON, e.g. key, status.display or followers.display;
+// values of arrays are joined with ", ". Rows are flushed after every page.
+func (t *TrackerClient) ExportIssuesCSV(w io.Writer, opts *FindIssuesOptions, fields []string) error {
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+	writer := csv.NewWriter(w)
+	if err := writer.Write(fields); err != nil {
+		return fmt.Errorf("csv write: %w", err)
+	}
+
+	listOpts := &ListOptions{PerPage: exportPerPage, Page: 1}
+	for {
+		req := t.NewRequest(resty.MethodPost, "/v2/issues/_search", opts)
+		listOpts.apply(req)
+		var issues []map[string]interface{}
+		resp, err := t.Do(req, &issues)
+		if err != nil {
+			return fmt.Errorf("request: %w", err)
+		}
+
+		if err := writeCSVRows(writer, issues, fields); err != nil {
+			return err
+		}
+
+		if !hasNextPage(resp, listOpts.Page, listOpts.PerPage, len(issues)) {
+			return nil
+		}
+		listOpts.Page++
+	}
+}
+
+// ExportIssuesCSVScroll
+// Write issues matching opts as ExportIssuesCSV does, scrolling through results tuned with scrollOpts.
+// Use it for exports over 10000 issues, which pagination cannot reach.
+func (t *TrackerClient) ExportIssuesCSVScroll(
+	w io.Writer, opts *FindIssuesOptions, scrollOpts *ScrollOptions, fields []string,
+) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(fields); err != nil {
+		return fmt.Errorf("csv write: %w", err)
+	}
+
+	return t.scrollSearch(opts, scrollOpts, func(req *resty.Request) (int, *resty.Response, error) {
+		var issues []map[string]interface{}
+		resp, err := t.Do(req, &issues)
+		if err != nil {
+			return 0, nil, fmt.Errorf("request: %w", err)
+		}
+		return len(issues), resp, writeCSVRows(writer, issues, fields)
+	})
+}
+
+// writeCSVRows
+// Write a row of field values for every issue and flush them
+func writeCSVRows(writer *csv.Writer, issues []map[string]interface{}, fields []string) error {
+	for _, issue := range issues {
+		row := make([]string, len(fields))
+		for i, field := range fields {
+			row[i] = formatValue(lookupPath(issue, strings.Split(field, ".")))
+		}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("csv write: %w", err)
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("csv flush: %w", err)
+	}
+
+	return nil
+}
+
+// lookupPath
+// Resolve a dotted path in decoded JSON, descending into every element of arrays
+func lookupPath(v interface{}, path []string) interface{} {
+	if len(path) == 0 {
+		return v
+	}
+	switch value := v.(type) {
+	case map[string]interface{}:
+		return lookupPath(value[path[0]], path[1:])
+	case []interface{}:
+		result := make([]interface{}, 0, len(value))
+		for _, item := range value {
+			if found := lookupPath(item, path); found != nil {
+				result = append(result, found)
+			}
+		}
+		return result
+	default:
+		return nil
+	}
+}
+
+func formatValue(v interface{}) string {
+	switch value := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case json.Number:
+		return value.String()
+	case bool:
+		return strconv.FormatBool(value)
+	case []interface{}:
+		parts := make([]string, len(value))
+		for i := range value {
+			parts[i] = formatValue(value[i])
+		}
+		return strings.Join(parts, ", ")
+	default:
+		return fmt.Sprint(value)
+	}
+}
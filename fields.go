@@ -0,0 +1,291 @@
+package tracker
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// FieldCategory structure in Yandex.Tracker
+// https://cloud.yandex.ru/en/docs/tracker/get-field-categories
+type FieldCategory struct {
+	// Address of the API resource with information about the category.
+	Self string `json:"self"`
+
+	// Category ID.
+	ID string `json:"id"`
+
+	// Category name displayed.
+	Name string `json:"name"`
+
+	// Category version.
+	Version int `json:"version"`
+
+	// Category description.
+	Description string `json:"description"`
+
+	// Category weight. This parameter affects the order of category display in the interface.
+	Order int `json:"order"`
+}
+
+func (t *TrackerClient) GetFieldCategories() ([]*FieldCategory, *resty.Response, error) {
+	req := t.NewRequest(resty.MethodGet, "/v2/fields/categories", nil)
+	var result []*FieldCategory
+	resp, err := t.Do(req, &result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+// Field structure in Yandex.Tracker
+// https://cloud.yandex.ru/en/docs/tracker/get-issue-field
+type Field struct {
+	// Address of the API resource with information about the field.
+	Self string `json:"self"`
+
+	// Field ID.
+	ID string `json:"id"`
+
+	// Field name displayed.
+	Name string `json:"name"`
+
+	// Field key.
+	Key string `json:"key"`
+
+	// Field version. Each change to the field increases its version number.
+	Version int `json:"version"`
+
+	// Object with information about the field value type.
+	Schema *FieldSchema `json:"schema"`
+
+	// Flag indicating that the field value cannot be edited.
+	Readonly bool `json:"readonly"`
+
+	// Flag indicating that the field has a list of allowed values.
+	Options bool `json:"options"`
+
+	// Flag indicating that value suggestions are shown when entering the field value.
+	Suggest bool `json:"suggest"`
+
+	// Object with information about the allowed values of the field.
+	OptionsProvider *FieldOptionsProvider `json:"optionsProvider"`
+
+	// Field weight. This parameter affects the order of field display in the interface.
+	Order int `json:"order"`
+
+	// Object with information about the field category.
+	Category *FieldCategoryRef `json:"category"`
+
+	// Field type: standard, local (queue field) or system.
+	Type string `json:"type"`
+}
+
+type FieldSchema struct {
+	// Field value type, for example string, date, user or array.
+	Type string `json:"type"`
+
+	// Type of the array elements for fields with the array type.
+	Items string `json:"items"`
+
+	// Flag indicating that the field is required.
+	Required bool `json:"required"`
+}
+
+type FieldOptionsProvider struct {
+	// Type of the provider, for example FixedListOptionsProvider.
+	Type string `json:"type"`
+
+	// Allowed values of the field.
+	Values []string `json:"values,omitempty"`
+}
+
+type FieldCategoryRef struct {
+	// Address of the API resource with information about the category.
+	Self string `json:"self"`
+
+	// Category ID.
+	ID string `json:"id"`
+
+	// Category name displayed.
+	Display string `json:"display"`
+}
+
+// Types of the values of local fields.
+const (
+	FieldTypeString   = "ru.yandex.startrek.core.fields.StringFieldType"
+	FieldTypeInteger  = "ru.yandex.startrek.core.fields.IntegerFieldType"
+	FieldTypeFloat    = "ru.yandex.startrek.core.fields.FloatFieldType"
+	FieldTypeDate     = "ru.yandex.startrek.core.fields.DateFieldType"
+	FieldTypeDateTime = "ru.yandex.startrek.core.fields.DateTimeFieldType"
+	FieldTypeUser     = "ru.yandex.startrek.core.fields.UserFieldType"
+)
+
+// Provider of a fixed list of values used by enumerated fields.
+const FixedListOptionsProvider = "FixedListOptionsProvider"
+
+// https://cloud.yandex.ru/en/docs/tracker/local-fields
+type CreateFieldOptions struct {
+	// Field key. Required.
+	ID *string `json:"id,omitempty"`
+
+	// Field name. Required.
+	Name *LocalizedName `json:"name,omitempty"`
+
+	// ID of the field category, see GetFieldCategories. Required.
+	Category *string `json:"category,omitempty"`
+
+	// Field value type, one of the FieldType constants. Required.
+	Type *string `json:"type,omitempty"`
+
+	// Allowed values of an enumerated field.
+	// Type must be FieldTypeString, FieldTypeInteger, FieldTypeFloat or FieldTypeUser,
+	// provider Type must be FixedListOptionsProvider and Values must not be empty.
+	OptionsProvider *FieldOptionsProvider `json:"optionsProvider,omitempty"`
+
+	// Field weight. This parameter affects the order of field display in the interface.
+	Order *int `json:"order,omitempty"`
+
+	// Field description.
+	Description *string `json:"description,omitempty"`
+
+	// Flag indicating that the field value cannot be edited.
+	Readonly *bool `json:"readonly,omitempty"`
+
+	// Flag indicating that the field is shown in the interface.
+	Visible *bool `json:"visible,omitempty"`
+
+	// Flag indicating that the field is hidden in the interface even when filled.
+	Hidden *bool `json:"hidden,omitempty"`
+
+	// Flag indicating that the field holds several values.
+	Container *bool `json:"container,omitempty"`
+}
+
+type LocalizedName struct {
+	// Name in English.
+	En string `json:"en"`
+
+	// Name in Russian.
+	Ru string `json:"ru,omitempty"`
+}
+
+// Validate
+// Check that the required options are set and an enumerated field has a well-formed list of values
+func (o *CreateFieldOptions) Validate() error {
+	switch {
+	case o == nil:
+		return &ValidationError{Field: "options", Reason: "must be set"}
+	case o.ID == nil || *o.ID == "":
+		return &ValidationError{Field: "id", Reason: "is required"}
+	case o.Name == nil || o.Name.En == "":
+		return &ValidationError{Field: "name.en", Reason: "is required"}
+	case o.Category == nil || *o.Category == "":
+		return &ValidationError{Field: "category", Reason: "is required"}
+	case o.Type == nil || *o.Type == "":
+		return &ValidationError{Field: "type", Reason: "is required"}
+	}
+
+	provider := o.OptionsProvider
+	if provider == nil {
+		return nil
+	}
+	switch *o.Type {
+	case FieldTypeString, FieldTypeInteger, FieldTypeFloat, FieldTypeUser:
+	default:
+		return &ValidationError{Field: "optionsProvider", Reason: "is not supported by type " + *o.Type}
+	}
+	if provider.Type != FixedListOptionsProvider {
+		return &ValidationError{Field: "optionsProvider.type", Reason: "must be " + FixedListOptionsProvider}
+	}
+	if len(provider.Values) == 0 {
+		return &ValidationError{Field: "optionsProvider.values", Reason: "must not be empty"}
+	}
+	seen := make(map[string]bool, len(provider.Values))
+	for _, value := range provider.Values {
+		if strings.TrimSpace(value) == "" {
+			return &ValidationError{Field: "optionsProvider.values", Reason: "must not contain empty values"}
+		}
+		if seen[value] {
+			return &ValidationError{Field: "optionsProvider.values", Reason: "contains duplicate value " + value}
+		}
+		seen[value] = true
+	}
+
+	return nil
+}
+
+// CreateQueueField
+// Create a local field in the queue. The options are validated before the request is sent.
+func (t *TrackerClient) CreateQueueField(queueKey string, opts *CreateFieldOptions) (*Field, *resty.Response, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	req := t.NewRequest(resty.MethodPost, "/v2/queues/"+queueKey+"/localFields", opts)
+	result := new(Field)
+	resp, err := t.Do(req, result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+// GetFields
+// Get global fields of the organization
+func (t *TrackerClient) GetFields() ([]*Field, *resty.Response, error) {
+	req := t.NewRequest(resty.MethodGet, "/v2/fields", nil)
+	var result []*Field
+	resp, err := t.Do(req, &result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+func (t *TrackerClient) GetField(fieldID string) (*Field, *resty.Response, error) {
+	req := t.NewRequest(resty.MethodGet, "/v2/fields/"+fieldID, nil)
+	result := new(Field)
+	resp, err := t.Do(req, result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+// FieldOption is an allowed value of an enumerated field.
+type FieldOption struct {
+	// Value ID as it is sent when setting the field.
+	ID string
+
+	// Field value.
+	Value string
+
+	// Value name displayed.
+	Display string
+}
+
+// GetFieldOptions
+// Get the allowed values of an enumerated field from its fixed list options provider.
+// Fields whose values come from other providers, e.g. users or queues, have no fixed list and return nil.
+func (t *TrackerClient) GetFieldOptions(fieldID string) ([]*FieldOption, error) {
+	field, _, err := t.GetField(fieldID)
+	if err != nil {
+		return nil, err
+	}
+	if field.OptionsProvider == nil || field.OptionsProvider.Type != FixedListOptionsProvider {
+		return nil, nil
+	}
+
+	result := make([]*FieldOption, len(field.OptionsProvider.Values))
+	for i, value := range field.OptionsProvider.Values {
+		result[i] = &FieldOption{ID: value, Value: value, Display: value}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,64 @@
+package tracker
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// StringList decodes a JSON string or an array of strings, so it fits custom fields
+// that return a single value or several depending on the field settings,
+// e.g. fixed list fields switched to multiple choice. Null decodes into nil.
+// Declare such fields with it in the struct passed to GetTicketInto or decoded from GetIssueRaw.
+type StringList []string
+
+func (l *StringList) UnmarshalJSON(data []byte) error {
+	var values []string
+	if err := unmarshalOneOrMany(data, &values, func() error {
+		var value string
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		values = []string{value}
+		return nil
+	}); err != nil {
+		return err
+	}
+	*l = values
+
+	return nil
+}
+
+// UserList decodes a user object or an array of them, so it fits custom user fields
+// that return a single user or several depending on the field settings. Null decodes into nil.
+type UserList []*BasicUser
+
+func (l *UserList) UnmarshalJSON(data []byte) error {
+	var values []*BasicUser
+	if err := unmarshalOneOrMany(data, &values, func() error {
+		value := new(BasicUser)
+		if err := json.Unmarshal(data, value); err != nil {
+			return err
+		}
+		values = []*BasicUser{value}
+		return nil
+	}); err != nil {
+		return err
+	}
+	*l = values
+
+	return nil
+}
+
+// unmarshalOneOrMany
+// Decode an array into many and pass anything else but null to one
+func unmarshalOneOrMany(data []byte, many interface{}, one func() error) error {
+	data = bytes.TrimSpace(data)
+	switch {
+	case bytes.Equal(data, []byte("null")):
+		return nil
+	case len(data) > 0 && data[0] == '[':
+		return json.Unmarshal(data, many)
+	default:
+		return one()
+	}
+}
@@ -0,0 +1,83 @@
+package tracker
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestStringListUnmarshal(t *testing.T) {
+	tests := []struct {
+		data string
+		want StringList
+	}{
+		{data: `"backend"`, want: StringList{"backend"}},
+		{data: `["backend","frontend"]`, want: StringList{"backend", "frontend"}},
+		{data: `[]`, want: StringList{}},
+		{data: `null`, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.data, func(t *testing.T) {
+			var got StringList
+			if err := json.Unmarshal([]byte(tt.data), &got); err != nil {
+				t.Fatalf("Unmarshal: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+
+	var invalid StringList
+	if err := json.Unmarshal([]byte(`{"key":"backend"}`), &invalid); err == nil {
+		t.Error("object decoded into StringList without an error")
+	}
+}
+
+func TestUserListUnmarshal(t *testing.T) {
+	tests := []struct {
+		data string
+		want []string
+	}{
+		{data: `{"id":"1","display":"Alice"}`, want: []string{"1"}},
+		{data: `[{"id":"1"},{"id":"2"}]`, want: []string{"1", "2"}},
+		{data: `null`, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.data, func(t *testing.T) {
+			var got UserList
+			if err := json.Unmarshal([]byte(tt.data), &got); err != nil {
+				t.Fatalf("Unmarshal: %v", err)
+			}
+			var ids []string
+			for _, user := range got {
+				ids = append(ids, user.ID)
+			}
+			if !reflect.DeepEqual(ids, tt.want) {
+				t.Errorf("ids = %v, want %v", ids, tt.want)
+			}
+		})
+	}
+}
+
+func TestStringListInTicket(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/v2/issues/TEST-1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		_, _ = w.Write([]byte(`{"key":"TEST-1","team":"backend","reviewers":{"id":"1"}}`))
+	})
+
+	var ticket struct {
+		Key       string     `json:"key"`
+		Team      StringList `json:"team"`
+		Reviewers UserList   `json:"reviewers"`
+	}
+	if err := client.GetTicketInto("TEST-1", &ticket); err != nil {
+		t.Fatalf("GetTicketInto: %v", err)
+	}
+	if len(ticket.Team) != 1 || ticket.Team[0] != "backend" || len(ticket.Reviewers) != 1 {
+		t.Errorf("ticket = %+v", ticket)
+	}
+}
@@ -0,0 +1,98 @@
+package tracker
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// FullIssue is an issue together with its comments, attachments and links.
+type FullIssue struct {
+	*Issue
+
+	// All comments of the issue.
+	Comments []*Comment
+
+	// Files attached to the issue.
+	Attachments []*Attachment
+
+	// Links to other issues.
+	Links []*IssueLink
+}
+
+// GetIssueFull
+// Get the issue, its comments, attachments and links concurrently.
+// If some of the requests fail, the data that was fetched is returned together with the joined errors.
+func (t *TrackerClient) GetIssueFull(issueKey string) (*FullIssue, error) {
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+		full = new(FullIssue)
+	)
+	fetch := func(name string, fn func() error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := fn(); err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("%s: %w", name, err))
+				mu.Unlock()
+			}
+		}()
+	}
+
+	fetch("issue", func() (err error) {
+		full.Issue, _, err = t.GetIssue(issueKey)
+		return err
+	})
+	fetch("comments", func() (err error) {
+		full.Comments, err = t.GetAllComments(issueKey)
+		return err
+	})
+	fetch("attachments", func() (err error) {
+		full.Attachments, _, err = t.GetAttachments(issueKey)
+		return err
+	})
+	fetch("links", func() (err error) {
+		full.Links, _, err = t.GetLinks(issueKey)
+		return err
+	})
+	wg.Wait()
+
+	return full, errors.Join(errs...)
+}
+
+// IssueWithEpic is an issue together with the summary and status of its epic.
+type IssueWithEpic struct {
+	*Issue
+
+	// Epic of the issue or, if it has none, its parent issue, with only the key, summary and status set.
+	// Nil if the issue has neither.
+	EpicIssue *Issue
+}
+
+// GetIssueWithEpic
+// Get the issue and the summary and status of its epic, or of its parent issue if it is not in an epic
+func (t *TrackerClient) GetIssueWithEpic(issueKey string) (*IssueWithEpic, error) {
+	issue, _, err := t.GetIssue(issueKey)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &IssueWithEpic{Issue: issue}
+	ref := issue.Epic
+	if ref == nil {
+		ref = issue.Parent
+	}
+	if ref == nil {
+		return result, nil
+	}
+
+	result.EpicIssue, _, err = t.GetIssueFields(ref.Key, []string{"key", "summary", "status"})
+	if err != nil {
+		return result, fmt.Errorf("epic %s: %w", ref.Key, err)
+	}
+
+	return result, nil
+}
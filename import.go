@@ -0,0 +1,206 @@
+package tracker
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Formats accepted by ImportFromReader.
+const (
+	ImportFormatCSV       = "csv"
+	ImportFormatJSONLines = "jsonl"
+)
+
+// Issue fields required by ImportIssue.
+var importRequiredFields = []string{"queue", "summary", "createdAt", "createdBy"}
+
+// FieldMapping maps CSV columns or JSON keys to issue field IDs, e.g. {"Title": "summary"}.
+// Columns and keys missing from the mapping are not imported.
+type FieldMapping map[string]string
+
+// ImportResult is the outcome of importing one row.
+type ImportResult struct {
+	// Row number starting at 1, not counting the CSV header.
+	Row int
+
+	// Created issue, nil if the row failed.
+	Issue *Issue
+
+	// Why the row failed, nil on success.
+	Err error
+}
+
+// ImportIssue
+// Import an issue created in another system, keeping its authors and dates.
+// The body must contain queue, summary, createdAt and createdBy; dates are in the YYYY-MM-DDThh:mm:ss.sss±hhmm format.
+// https://cloud.yandex.ru/en/docs/tracker/concepts/import/import-ticket
+func (t *TrackerClient) ImportIssue(body map[string]interface{}) (*Issue, *resty.Response, error) {
+	for _, field := range importRequiredFields {
+		if body[field] == nil {
+			return nil, nil, &ValidationError{Field: field, Reason: "is required to import an issue"}
+		}
+	}
+
+	req := t.NewRequest(resty.MethodPost, "/v2/issues/_import", body)
+	result := new(Issue)
+	resp, err := t.Do(req, result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+// https://cloud.yandex.ru/en/docs/tracker/concepts/import/import-comments
+type ImportCommentOptions struct {
+	// Comment text. Required.
+	Text string `json:"text"`
+
+	// Date and time when the comment was created. Required.
+	CreatedAt TrackerTime `json:"createdAt"`
+
+	// Username or ID of the comment author. Required.
+	CreatedBy string `json:"createdBy"`
+
+	// Date and time when the comment was last updated.
+	UpdatedAt *TrackerTime `json:"updatedAt,omitempty"`
+
+	// Username or ID of the user who last updated the comment.
+	UpdatedBy string `json:"updatedBy,omitempty"`
+}
+
+// ImportComment
+// Import a comment written in another system, keeping its author and dates
+func (t *TrackerClient) ImportComment(issueKey string, opts *ImportCommentOptions) (*Comment, *resty.Response, error) {
+	switch {
+	case opts == nil || opts.Text == "":
+		return nil, nil, &ValidationError{Field: "text", Reason: "is required to import a comment"}
+	case opts.CreatedAt.IsZero():
+		return nil, nil, &ValidationError{Field: "createdAt", Reason: "is required to import a comment"}
+	case opts.CreatedBy == "":
+		return nil, nil, &ValidationError{Field: "createdBy", Reason: "is required to import a comment"}
+	}
+
+	req := t.NewRequest(resty.MethodPost, "/v2/issues/"+issueKey+"/comments/_import", opts)
+	result := new(Comment)
+	resp, err := t.Do(req, result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+// ImportFromReader
+// Create an issue from every CSV row or JSON line read from r, renaming columns and keys with mapping.
+// Rows with createdAt and createdBy are imported with ImportIssue to keep their history, other rows are created
+// as new issues in their queue or the one set with WithDefaultQueue. Empty CSV cells are skipped.
+// A failed row does not stop the import: results hold the outcome of every row, and the error is only returned
+// when the input cannot be read.
+func (t *TrackerClient) ImportFromReader(r io.Reader, format string, mapping FieldMapping) ([]*ImportResult, error) {
+	var next func() (map[string]interface{}, error)
+	switch format {
+	case ImportFormatCSV:
+		reader := csv.NewReader(r)
+		header, err := reader.Read()
+		if err != nil {
+			return nil, fmt.Errorf("csv header: %w", err)
+		}
+		next = func() (map[string]interface{}, error) {
+			record, err := reader.Read()
+			if errors.Is(err, csv.ErrFieldCount) {
+				return nil, &rowError{fmt.Errorf("csv: %w", err)}
+			}
+			if err != nil {
+				return nil, err
+			}
+			row := make(map[string]interface{}, len(record))
+			for i, value := range record {
+				if i < len(header) && value != "" {
+					row[header[i]] = value
+				}
+			}
+			return row, nil
+		}
+	case ImportFormatJSONLines:
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(nil, 1<<20)
+		next = func() (map[string]interface{}, error) {
+			for scanner.Scan() {
+				line := bytes.TrimSpace(scanner.Bytes())
+				if len(line) == 0 {
+					continue
+				}
+				var row map[string]interface{}
+				if err := json.Unmarshal(line, &row); err != nil {
+					return nil, &rowError{fmt.Errorf("json: %w", err)}
+				}
+				return row, nil
+			}
+			if err := scanner.Err(); err != nil {
+				return nil, err
+			}
+			return nil, io.EOF
+		}
+	default:
+		return nil, &ValidationError{Field: "format", Reason: fmt.Sprintf("%q is not %s or %s", format, ImportFormatCSV, ImportFormatJSONLines)}
+	}
+
+	var results []*ImportResult
+	for n := 1; ; n++ {
+		row, err := next()
+		var rowErr *rowError
+		switch {
+		case errors.Is(err, io.EOF):
+			return results, nil
+		case errors.As(err, &rowErr):
+			results = append(results, &ImportResult{Row: n, Err: rowErr.err})
+			continue
+		case err != nil:
+			return results, fmt.Errorf("row %d: %w", n, err)
+		}
+
+		body := make(map[string]interface{}, len(row))
+		for source, value := range row {
+			if field, ok := mapping[source]; ok {
+				body[field] = value
+			}
+		}
+		issue, err := t.importRow(body)
+		results = append(results, &ImportResult{Row: n, Issue: issue, Err: err})
+	}
+}
+
+func (t *TrackerClient) importRow(body map[string]interface{}) (*Issue, error) {
+	if body["createdAt"] != nil && body["createdBy"] != nil {
+		issue, _, err := t.ImportIssue(body)
+		return issue, err
+	}
+
+	if body["queue"] == nil && t.defaultQueue != "" {
+		body["queue"] = t.defaultQueue
+	}
+	req := t.NewRequest(resty.MethodPost, "/v2/issues/", body)
+	result := new(Issue)
+	if _, err := t.Do(req, result); err != nil {
+		return nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, nil
+}
+
+// rowError marks a row that cannot be parsed while the rest of the input is still readable.
+type rowError struct {
+	err error
+}
+
+func (e *rowError) Error() string {
+	return e.err.Error()
+}
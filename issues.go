@@ -1,7 +1,18 @@
 package tracker
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/go-resty/resty/v2"
 )
@@ -37,7 +48,13 @@ type Issue struct {
 	Version int `json:"version"`
 
 	// Date and time when the last comment was added.
-	LastCommentUpdatedAt string `json:"lastCommentUpdatedAt"`
+	LastCommentUpdatedAt TrackerTime `json:"lastCommentUpdatedAt"`
+
+	// Number of comments that were not sent by email.
+	CommentWithoutExternalMessageCount int `json:"commentWithoutExternalMessageCount"`
+
+	// Number of comments sent or received by email.
+	CommentWithExternalMessageCount int `json:"commentWithExternalMessageCount"`
 
 	// Issue name.
 	Summary string `json:"summary"`
@@ -45,6 +62,9 @@ type Issue struct {
 	// Object with information about the parent issue.
 	Parent *BasicIssue `json:"parent"`
 
+	// Object with information about the epic the issue belongs to.
+	Epic *BasicIssue `json:"epic"`
+
 	// Array with information about alternative issue keys.
 	Aliases []string `json:"aliases"`
 
@@ -57,6 +77,21 @@ type Issue struct {
 	// Array of objects with information about the sprint.
 	Sprint []*BasicSprint `json:"sprint"`
 
+	// Array of objects with the IDs of the boards the issue is on.
+	Boards []*BoardRef `json:"boards"`
+
+	// Issue tags.
+	Tags []string `json:"tags"`
+
+	// Issue deadline in the YYYY-MM-DD format.
+	Deadline string `json:"deadline"`
+
+	// Start date of the work on the issue in the YYYY-MM-DD format, as shown on the Gantt chart.
+	Start string `json:"start"`
+
+	// End date of the work on the issue in the YYYY-MM-DD format, as shown on the Gantt chart.
+	End string `json:"end"`
+
 	// Object with information about the issue type.
 	Type *IssueType `json:"type"`
 
@@ -64,17 +99,27 @@ type Issue struct {
 	Priority *BasicPriority `json:"priority"`
 
 	// Issue creation date and time.
-	CreatedAt string `json:"createdAt"`
+	CreatedAt TrackerTime `json:"createdAt"`
 
 	// Array of objects with information about issue followers.
 	Followers []*BasicUser `json:"followers"`
 
+	// Array of objects with information about the users granted access to the issue.
+	// Only returned when access to the issue is restricted.
+	Access []*BasicUser `json:"access"`
+
+	// Array of objects with information about the mailing lists following the issue.
+	FollowingMaillists []*BasicUser `json:"followingMaillists"`
+
 	// Object with information about the user who created the issue.
 	CreatedBy *BasicUser `json:"createdBy"`
 
 	// Number of votes for the issue.
 	Votes int `json:"votes"`
 
+	// Array of objects with information about the users who voted for the issue.
+	VotedBy []*BasicUser `json:"votedBy"`
+
 	// Object with information about the issue's assignee.
 	Assignee *BasicUser `json:"assignee"`
 
@@ -82,11 +127,20 @@ type Issue struct {
 	Queue *BasicQueue `json:"queue"`
 
 	// Date and time when the issue was last updated.
-	UpdatedAt string `json:"updatedAt"`
+	UpdatedAt TrackerTime `json:"updatedAt"`
+
+	// Date and time when the issue was resolved.
+	ResolvedAt TrackerTime `json:"resolvedAt"`
+
+	// Object with information about the issue resolution, nil while the issue is not resolved.
+	Resolution *BasicResolution `json:"resolution"`
 
 	// Object with information about the issue status.
 	Status *BasicStatus `json:"status"`
 
+	// Object with information about the type of the issue status.
+	StatusType *StatusType `json:"statusType"`
+
 	// Object with information about the previous status of the issue.
 	PreviousStatus *BasicStatus `json:"previousStatus"`
 
@@ -94,6 +148,37 @@ type Issue struct {
 	// true: Issue added to favorites by the user.
 	// false: Issue not added to favorites.
 	Favorite bool `json:"favorite"`
+
+	// Original time estimate.
+	OriginalEstimation *TrackerDuration `json:"originalEstimation"`
+
+	// Remaining time estimate.
+	Estimation *TrackerDuration `json:"estimation"`
+
+	// Time spent on the issue.
+	Spent *TrackerDuration `json:"spent"`
+
+	// Array of objects with information about the checklist items.
+	ChecklistItems []*ChecklistItem `json:"checklistItems"`
+
+	// Array of objects with information about the SLA timers of the issue.
+	SLA []*SLA `json:"sla"`
+
+	// Array of objects with information about the attached files.
+	// Returned when the issue is requested with expand=attachments, e.g. ListOptions{Expand: ExpandAttachments}.
+	Attachments []*Attachment `json:"attachments"`
+
+	// All fields of the issue as returned by the API keyed by field ID, including local and custom fields.
+	// Read them with GetString, GetFloat, GetNumber, GetStringSlice and GetUserField.
+	// A field returned as null is kept as null, use HasField to tell it from a field that was not returned.
+	Fields map[string]json.RawMessage `json:"-"`
+
+	// Values of the fields with a decoder registered with RegisterFieldDecoder, keyed by field ID.
+	// Read them with GetDecoded.
+	Decoded map[string]interface{} `json:"-"`
+
+	// Errors returned by the registered decoders, keyed by field ID. Fields that failed are missing from Decoded.
+	DecodeErrors map[string]error `json:"-"`
 }
 
 // https://cloud.yandex.ru/en/docs/tracker/concepts/issues/create-issue
@@ -125,7 +210,8 @@ type CreateIssueOptions struct {
 	Priority interface{} `json:"priority,omitempty"`
 
 	// IDs or usernames of issue followers.
-	// Array of objects, numbers, or strings.
+	// Array of objects, numbers, or strings, e.g. &[]interface{}{"login", 1120000000016876}.
+	// Followers set on creation are notified right away, no separate update is needed.
 	Followers *[]interface{} `json:"followers,omitempty"`
 
 	// ID or username of issue assignee.
@@ -138,22 +224,90 @@ type CreateIssueOptions struct {
 
 	// Field with a unique value that disables creation of duplicate issues.
 	// If you try to create an issue with the same value of this parameter again, no duplicate will be created and the response will contain an error with code 409.
+	// The existing issue is not returned: check the error with errors.Is(err, ErrConflict) and look the issue up by the unique value.
 	Unique *string `json:"unique,omitempty"`
 
 	// List of attachment IDs.
 	// Array of strings
 	AttachmentIDs *[]string `json:"attachmentIds,omitempty"`
+
+	// Original time estimate, sent in ISO 8601 format.
+	OriginalEstimation *TrackerDuration `json:"originalEstimation,omitempty"`
+
+	// Remaining time estimate, sent in ISO 8601 format.
+	Estimation *TrackerDuration `json:"estimation,omitempty"`
+
+	// Local and other issue fields, keyed by field key, e.g. map[string]interface{}{"myLocalField": "value"}.
+	// The named fields above take precedence over entries with the same key.
+	Fields map[string]interface{} `json:"-"`
+
+	// Notification settings passed as query parameters.
+	Notify *NotifyOptions `json:"-"`
+
+	// Language of the request sent in the Accept-Language header, e.g. en or ru.
+	// It selects the language of localized names in the response and in notifications;
+	// mentions like @login are resolved by login and do not depend on it.
+	Language string `json:"-"`
+}
+
+// MarshalJSON
+// Write the named fields and Fields as one flat object as the API expects
+func (o *CreateIssueOptions) MarshalJSON() ([]byte, error) {
+	type named CreateIssueOptions
+	data, err := json.Marshal((*named)(o))
+	if err != nil || len(o.Fields) == 0 {
+		return data, err
+	}
+
+	var namedFields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &namedFields); err != nil {
+		return nil, err
+	}
+	body := make(map[string]interface{}, len(o.Fields)+len(namedFields))
+	for key, value := range o.Fields {
+		body[key] = value
+	}
+	for key, value := range namedFields {
+		body[key] = value
+	}
+
+	return json.Marshal(body)
 }
 
+// Values of ListOptions.Expand.
+const (
+	ExpandTransitions = "transitions"
+	ExpandAttachments = "attachments"
+)
+
 type ListOptions struct {
 	// Additional fields to be included into the response:
 	// transitions: Workflow transitions between statuses
 	// attachments: Attached files
+	// Expanding attachments adds their metadata to every issue, so lower PerPage to keep pages small.
 	Expand string
 
 	// Number of issues per response page. The default value is 50. To set up additional response output parameters, use pagination.
 	// https://cloud.yandex.ru/en/docs/tracker/common-format#displaying-results
 	PerPage int
+
+	// Number of the response page to return. The default value is 1.
+	Page int
+}
+
+func (o *ListOptions) apply(req *resty.Request) {
+	if o == nil {
+		return
+	}
+	if o.Expand != "" {
+		req.SetQueryParam("expand", o.Expand)
+	}
+	if o.PerPage > 0 {
+		req.SetQueryParam("perPage", fmt.Sprint(o.PerPage))
+	}
+	if o.Page > 0 {
+		req.SetQueryParam("page", fmt.Sprint(o.Page))
+	}
 }
 
 type FindIssuesOptions struct {
@@ -165,34 +319,271 @@ type FindIssuesOptions struct {
 	Keys interface{} `json:"keys,omitempty"`
 
 	// Issue filtering parameters. The parameter can specify any field and value to filter by.
+	// Values are matched exactly by field key, e.g. {"assignee": "login"}, and combined with AND.
+	// Cannot be used together with Query.
 	Filter map[string]interface{} `json:"filter,omitempty"`
 
-	// Filter using the query language
+	// Filter using the query language, as typed in the Tracker search bar.
+	// Fields are referred to by their displayed names, e.g. Assignee: login, and values support functions like me() or now().
+	// Cannot be used together with Filter.
 	// https://cloud.yandex.ru/en/docs/tracker/user/query-filter
 	Query *string `json:"query,omitempty"`
+
+	// Sorting of the results, applied in the given order.
+	// Only used together with Filter: a Query is sorted by its own "Sort by:" clause and ignores Order.
+	Order SortKeys `json:"order,omitempty"`
+}
+
+// Validate
+// Check that the search is set either with Filter or with Query. The API silently prefers one of them,
+// which makes a search with both return unexpected results.
+func (o *FindIssuesOptions) Validate() error {
+	if o != nil && len(o.Filter) > 0 && o.Query != nil {
+		return &ValidationError{Field: "options", Reason: "filter and query cannot be used together"}
+	}
+
+	return nil
+}
+
+// SortKey is a field to sort search results by.
+type SortKey struct {
+	// Field key, for example updatedAt.
+	Field string
+
+	// Sort in descending order.
+	Desc bool
+}
+
+func (k SortKey) String() string {
+	if k.Desc {
+		return "-" + k.Field
+	}
+
+	return "+" + k.Field
+}
+
+type SortKeys []SortKey
+
+// MarshalJSON
+// Write a single key as the "+field" string the API expects and several keys as an array of such strings
+func (k SortKeys) MarshalJSON() ([]byte, error) {
+	keys := make([]string, len(k))
+	for i := range k {
+		keys[i] = k[i].String()
+	}
+	if len(keys) == 1 {
+		return json.Marshal(keys[0])
+	}
+
+	return json.Marshal(keys)
 }
 
+// CreateIssue
+// Create issue. If opts has no queue, the queue set with WithDefaultQueue is used.
 func (t *TrackerClient) CreateIssue(opts *CreateIssueOptions) (*Issue, *resty.Response, error) {
+	if opts != nil && opts.Queue == nil && t.defaultQueue != "" {
+		withQueue := *opts
+		withQueue.Queue = t.defaultQueue
+		opts = &withQueue
+	}
 	req := t.NewRequest(resty.MethodPost, "/v2/issues/", opts)
+	if opts != nil {
+		opts.Notify.apply(req)
+		if opts.Language != "" {
+			req.SetHeader("Accept-Language", opts.Language)
+		}
+	}
 	result := new(Issue)
 	resp, err := t.Do(req, result)
 	if err != nil {
 		return nil, nil, fmt.Errorf("request: %w", err)
 	}
+	if result.Key == "" && !IsDryRun(resp) {
+		result = t.completeCreatedIssue(result, resp)
+	}
 	return result, resp, nil
 }
 
-func (t *TrackerClient) FindIssues(opts *FindIssuesOptions, listOpts *ListOptions) ([]*Issue, *resty.Response, error) {
-	req := t.NewRequest(resty.MethodPost, "/v2/issues/_search", opts)
-	// TODO:
-	if listOpts != nil {
-		if listOpts.Expand != "" {
-			req.SetQueryParam("expand", listOpts.Expand)
+// completeCreatedIssue
+// Fill in the key of a created issue from a response body without it, taking it from the Location header
+// or fetching the issue by the ID. The issue is returned as is if neither is possible
+func (t *TrackerClient) completeCreatedIssue(issue *Issue, resp *resty.Response) *Issue {
+	ref := issue.ID
+	if location := resp.Header().Get("Location"); location != "" {
+		if issue.Self == "" {
+			issue.Self = location
+		}
+		if u, err := url.Parse(location); err == nil && issueKeyPattern.MatchString(path.Base(u.Path)) {
+			ref = path.Base(u.Path)
+		}
+	}
+	switch {
+	case ref == "":
+		t.logger.Warnf("created issue has no key in the response")
+		return issue
+	case strings.Contains(ref, "-"):
+		issue.Key = ref
+		return issue
+	}
+
+	fetched, _, err := t.GetIssue(ref)
+	if err != nil {
+		t.logger.Warnf("get created issue %s: %v", ref, err)
+		return issue
+	}
+
+	return fetched
+}
+
+// CreateIssueValidated
+// Create the issue after checking the options against the fields of its queue: required fields must be set
+// and fields with a fixed list of values must hold one of them. Set local fields in Fields. All failed checks are returned together
+// as ValidationErrors and no issue is created. The queue must be set as a key or default to WithDefaultQueue.
+func (t *TrackerClient) CreateIssueValidated(opts *CreateIssueOptions) (*Issue, error) {
+	if opts == nil {
+		return nil, &ValidationError{Field: "options", Reason: "must be set"}
+	}
+	queueKey := t.defaultQueue
+	switch queue := opts.Queue.(type) {
+	case nil:
+	case string:
+		queueKey = queue
+	case map[string]interface{}:
+		queueKey, _ = queue["key"].(string)
+	default:
+		queueKey = ""
+	}
+	if queueKey == "" {
+		return nil, &ValidationError{Field: "queue", Reason: "must be set as a key to validate the issue"}
+	}
+
+	fields, _, err := t.GetQueueFields(queueKey)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(opts)
+	if err != nil {
+		return nil, fmt.Errorf("json.Marshal: %w", err)
+	}
+	var body map[string]interface{}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal: %w", err)
+	}
+	if _, ok := body["queue"]; !ok {
+		body["queue"] = queueKey
+	}
+
+	if errs := validateFields(fields, body); len(errs) > 0 {
+		return nil, errs
+	}
+	issue, _, err := t.CreateIssue(opts)
+
+	return issue, err
+}
+
+// validateFields
+// Check the body for missing required fields and values outside the fixed lists of the fields.
+// Read-only fields cannot be set and are not checked
+func validateFields(fields []*Field, body map[string]interface{}) ValidationErrors {
+	var errs ValidationErrors
+	for _, field := range fields {
+		if field.Readonly {
+			continue
+		}
+		name, value := fieldValue(field, body)
+		if value == nil {
+			if field.Schema != nil && field.Schema.Required {
+				errs = append(errs, &ValidationError{Field: name, Reason: "is required"})
+			}
+			continue
+		}
+
+		provider := field.OptionsProvider
+		if provider == nil || provider.Type != FixedListOptionsProvider {
+			continue
+		}
+		values, ok := value.([]interface{})
+		if !ok {
+			values = []interface{}{value}
+		}
+		for _, v := range values {
+			if !containsString(provider.Values, fmt.Sprint(v)) {
+				errs = append(errs, &ValidationError{
+					Field:  name,
+					Reason: fmt.Sprintf("%v is not one of %s", v, strings.Join(provider.Values, ", ")),
+				})
+			}
+		}
+	}
+
+	return errs
+}
+
+// fieldValue
+// Get the value of the field from the body by its key or, failing that, by its ID, e.g. <queueId>--<key> for local fields,
+// together with the name it was set under
+func fieldValue(field *Field, body map[string]interface{}) (string, interface{}) {
+	if field.Key != "" {
+		if value, ok := body[field.Key]; ok {
+			return field.Key, value
 		}
-		if listOpts.PerPage > 0 {
-			req.SetQueryParam("perPage", fmt.Sprint(listOpts.PerPage))
+	}
+	if value, ok := body[field.ID]; ok || field.Key == "" {
+		return field.ID, value
+	}
+
+	return field.Key, nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
 		}
 	}
+
+	return false
+}
+
+func (t *TrackerClient) FindIssues(opts *FindIssuesOptions, listOpts *ListOptions) ([]*Issue, *resty.Response, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	req := t.NewRequest(resty.MethodPost, "/v2/issues/_search", opts)
+	listOpts.apply(req)
+	var result []*Issue
+	resp, err := t.Do(req, &result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+// CountIssues
+// Count the issues matching the search without fetching them
+// https://cloud.yandex.ru/en/docs/tracker/concepts/issues/count-issues
+func (t *TrackerClient) CountIssues(opts *FindIssuesOptions) (int, *resty.Response, error) {
+	if err := opts.Validate(); err != nil {
+		return 0, nil, err
+	}
+
+	req := t.NewRequest(resty.MethodPost, "/v2/issues/_count", opts)
+	var result int
+	resp, err := t.Do(req, &result)
+	if err != nil {
+		return 0, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+// FindIssuesRaw
+// Search issues with an arbitrary request body, for search parameters FindIssuesOptions does not cover
+func (t *TrackerClient) FindIssuesRaw(body json.RawMessage, listOpts *ListOptions) ([]*Issue, *resty.Response, error) {
+	req := t.NewRequest(resty.MethodPost, "/v2/issues/_search", body)
+	listOpts.apply(req)
 	var result []*Issue
 	resp, err := t.Do(req, &result)
 	if err != nil {
@@ -202,7 +593,28 @@ func (t *TrackerClient) FindIssues(opts *FindIssuesOptions, listOpts *ListOption
 	return result, resp, nil
 }
 
+// issueKeyPattern matches issue keys such as QUEUE-123 and issue IDs such as 593cd211ef7e8a332414f2a7.
+var issueKeyPattern = regexp.MustCompile(`^(?:[A-Za-z][A-Za-z0-9]*-[0-9]+|[0-9a-f]{24})$`)
+
+// ValidateIssueKey
+// Check that the value looks like an issue key or ID before a request is sent
+func ValidateIssueKey(issueKey string) error {
+	if !issueKeyPattern.MatchString(issueKey) {
+		return &ValidationError{Field: "issue key", Reason: fmt.Sprintf("%q is not a key like QUEUE-123 or an ID", issueKey)}
+	}
+
+	return nil
+}
+
+// GetIssue
+// Get issue by key or ID. Both identify the issue in /v2/issues/{id-or-key},
+// so IDs received from webhooks can be passed as is.
+// Malformed keys are rejected with a *ValidationError without a request.
 func (t *TrackerClient) GetIssue(issueKey string) (*Issue, *resty.Response, error) {
+	if err := ValidateIssueKey(issueKey); err != nil {
+		return nil, nil, err
+	}
+
 	req := t.NewRequest(resty.MethodGet, "/v2/issues/"+issueKey, nil)
 	result := new(Issue)
 	resp, err := t.Do(req, result)
@@ -212,3 +624,488 @@ func (t *TrackerClient) GetIssue(issueKey string) (*Issue, *resty.Response, erro
 
 	return result, resp, err
 }
+
+// GetIssueRaw
+// Get issue as the untouched JSON returned by Tracker, keeping fields the Issue struct does not model
+func (t *TrackerClient) GetIssueRaw(issueKey string) (json.RawMessage, *resty.Response, error) {
+	if err := ValidateIssueKey(issueKey); err != nil {
+		return nil, nil, err
+	}
+
+	req := t.NewRequest(resty.MethodGet, "/v2/issues/"+issueKey, nil)
+	var result json.RawMessage
+	resp, err := t.Do(req, &result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+// GetIssueFields
+// Get issue with only the listed fields in the response
+func (t *TrackerClient) GetIssueFields(issueKey string, fields []string) (*Issue, *resty.Response, error) {
+	if err := ValidateIssueKey(issueKey); err != nil {
+		return nil, nil, err
+	}
+
+	req := t.NewRequest(resty.MethodGet, "/v2/issues/"+issueKey, nil)
+	if len(fields) > 0 {
+		req.SetQueryParam("fields", strings.Join(fields, ","))
+	}
+	result := new(Issue)
+	resp, err := t.Do(req, result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+// GetIssueIfModifiedSince
+// Get the issue only if it was updated after since, checking first with a request for updatedAt alone.
+// Reports false with a nil issue if the issue is unchanged.
+func (t *TrackerClient) GetIssueIfModifiedSince(issueKey string, since time.Time) (*Issue, bool, error) {
+	stamp, _, err := t.GetIssueFields(issueKey, []string{"updatedAt"})
+	if err != nil {
+		return nil, false, err
+	}
+	if !stamp.UpdatedAt.After(since) {
+		return nil, false, nil
+	}
+
+	issue, _, err := t.GetIssue(issueKey)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return issue, true, nil
+}
+
+// GetIssues
+// Get issues by keys concurrently, limiting each response to fields if any are given.
+// Issues are returned in the order of keys; keys that failed are skipped and reported in a *BatchError.
+func (t *TrackerClient) GetIssues(keys []string, fields []string) ([]*Issue, error) {
+	var mu sync.Mutex
+	found := make(map[string]*Issue, len(keys))
+	err := t.BatchExecute(context.Background(), keys, func(ctx context.Context, key string) error {
+		issue, _, err := t.GetIssueFields(key, fields)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		found[key] = issue
+		mu.Unlock()
+		return nil
+	})
+
+	result := make([]*Issue, 0, len(found))
+	for _, key := range keys {
+		if issue, ok := found[key]; ok {
+			result = append(result, issue)
+		}
+	}
+
+	return result, err
+}
+
+// Number of keys per search request made by GetIssuesBatch.
+const issuesBatchSize = 50
+
+// GetIssuesBatch
+// Get issues by keys with one search request per 50 keys instead of one request per key.
+// Issues are returned in the order of keys, followed by issues found under another key, e.g. after a move;
+// keys that do not exist are skipped.
+func (t *TrackerClient) GetIssuesBatch(keys []string) ([]*Issue, error) {
+	var found []*Issue
+	for start := 0; start < len(keys); start += issuesBatchSize {
+		end := start + issuesBatchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+		issues, err := t.FindAllIssues(&FindIssuesOptions{Keys: keys[start:end]})
+		if err != nil {
+			return nil, err
+		}
+		found = append(found, issues...)
+	}
+
+	byKey := make(map[string]*Issue, len(found))
+	for _, issue := range found {
+		byKey[issue.Key] = issue
+	}
+	result := make([]*Issue, 0, len(found))
+	for _, key := range keys {
+		if issue, ok := byKey[key]; ok {
+			result = append(result, issue)
+			delete(byKey, key)
+		}
+	}
+	for _, issue := range found {
+		if _, ok := byKey[issue.Key]; ok {
+			result = append(result, issue)
+			delete(byKey, issue.Key)
+		}
+	}
+
+	return result, nil
+}
+
+// IssueExists
+// Check whether the issue exists. A 404 response is reported as false without an error.
+func (t *TrackerClient) IssueExists(issueKey string) (bool, error) {
+	_, _, err := t.GetIssue(issueKey)
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, ErrNotFound):
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// FollowMovedIssue
+// Get the issue by a key it had before being moved to another queue. The API resolves old keys,
+// so the returned issue holds the current key in Key and the old ones in Aliases.
+func (t *TrackerClient) FollowMovedIssue(oldKey string) (*Issue, error) {
+	issue, _, err := t.GetIssue(oldKey)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(issue.Key, oldKey) {
+		t.logger.Debugf("issue %s was moved to %s", oldKey, issue.Key)
+	}
+
+	return issue, nil
+}
+
+// UpdateIssueRaw
+// Patch issue fields with an arbitrary JSON body, e.g. arrays or nested objects
+// that PatchTicket cannot express. An empty body is rejected as a no-op update.
+func (t *TrackerClient) UpdateIssueRaw(issueKey string, body map[string]interface{}) (*Issue, *resty.Response, error) {
+	if len(body) == 0 {
+		return nil, nil, &ValidationError{Field: "body", Reason: "must not be empty"}
+	}
+
+	req := t.NewRequest(resty.MethodPatch, "/v2/issues/"+issueKey, body)
+	result := new(Issue)
+	resp, err := t.Do(req, result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+// https://cloud.yandex.ru/en/docs/tracker/concepts/issues/patch-issue
+type UpdateIssueOptions struct {
+	// Issue name.
+	Summary *string
+
+	// Issue description.
+	Description *string
+
+	// Key of the parent issue.
+	Parent *string
+
+	// Remove the parent issue.
+	ClearParent bool
+
+	// Issue type key.
+	Type *string
+
+	// Issue priority key.
+	Priority *string
+
+	// Username of issue assignee.
+	Assignee *string
+
+	// Mailing list addresses following the issue.
+	FollowingMaillists *ListUpdate
+
+	// Other issue fields, keyed by field ID.
+	// User array fields take a slice of logins, e.g. map[string]interface{}{"reviewers": []string{"alice", "bob"}}.
+	// A nil value is sent as null and clears the field, fields missing from the map are left unchanged.
+	// A key also set with a named option above, e.g. summary with Summary, is rejected by UpdateIssue.
+	Fields map[string]interface{}
+
+	// Notification settings passed as query parameters.
+	Notify *NotifyOptions
+}
+
+// ClearField
+// Clear the field with fieldKey by sending null for it
+func (o *UpdateIssueOptions) ClearField(fieldKey string) *UpdateIssueOptions {
+	if o.Fields == nil {
+		o.Fields = make(map[string]interface{})
+	}
+	o.Fields[fieldKey] = nil
+	return o
+}
+
+// validate
+// Check that no field is set both with a named option and in Fields, where the named option would silently win
+func (o *UpdateIssueOptions) validate() error {
+	for key, set := range map[string]bool{
+		"summary":            o.Summary != nil,
+		"description":        o.Description != nil,
+		"type":               o.Type != nil,
+		"priority":           o.Priority != nil,
+		"assignee":           o.Assignee != nil,
+		"followingMaillists": o.FollowingMaillists != nil,
+		"parent":             o.Parent != nil || o.ClearParent,
+	} {
+		if _, ok := o.Fields[key]; ok && set {
+			return &ValidationError{Field: key, Reason: "is set both with a named option and in Fields"}
+		}
+	}
+
+	return nil
+}
+
+func (o *UpdateIssueOptions) body() map[string]interface{} {
+	body := make(map[string]interface{}, len(o.Fields)+6)
+	for key, value := range o.Fields {
+		body[key] = value
+	}
+	for key, value := range map[string]*string{
+		"summary":     o.Summary,
+		"description": o.Description,
+		"type":        o.Type,
+		"priority":    o.Priority,
+		"assignee":    o.Assignee,
+	} {
+		if value != nil {
+			body[key] = *value
+		}
+	}
+	if value := o.FollowingMaillists.value(); value != nil {
+		body["followingMaillists"] = value
+	}
+	switch {
+	case o.ClearParent:
+		body["parent"] = nil
+	case o.Parent != nil:
+		body["parent"] = *o.Parent
+	}
+
+	return body
+}
+
+// ListUpdate changes an array field: Set replaces its values, otherwise Add and Remove change them in place.
+type ListUpdate struct {
+	// Values replacing the current ones.
+	Set []string
+
+	// Values added to the current ones.
+	Add []string
+
+	// Values removed from the current ones.
+	Remove []string
+}
+
+// value
+// Get the value to send for the field, nil if the update changes nothing and the field should be left out
+func (u *ListUpdate) value() interface{} {
+	switch {
+	case u == nil:
+		return nil
+	case u.Set != nil:
+		return u.Set
+	case len(u.Add) == 0 && len(u.Remove) == 0:
+		return nil
+	}
+	operations := make(map[string][]string, 2)
+	if len(u.Add) > 0 {
+		operations["add"] = u.Add
+	}
+	if len(u.Remove) > 0 {
+		operations["remove"] = u.Remove
+	}
+
+	return operations
+}
+
+// MarshalJSON
+// Write the named and other fields as one flat object as the API expects
+func (o *UpdateIssueOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.body())
+}
+
+// UpdateIssue
+// Update issue fields, returning the updated issue. Options that set nothing are rejected as a no-op update.
+func (t *TrackerClient) UpdateIssue(issueKey string, opts *UpdateIssueOptions) (*Issue, *resty.Response, error) {
+	if opts == nil || len(opts.body()) == 0 {
+		return nil, nil, &ValidationError{Field: "options", Reason: "must set at least one field"}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	req := t.NewRequest(resty.MethodPatch, "/v2/issues/"+issueKey, opts)
+	opts.Notify.apply(req)
+	result := new(Issue)
+	resp, err := t.Do(req, result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+// ChangeIssueType
+// Change the issue type by key, e.g. bug. If the new type requires fields the issue does not have,
+// returns a *MissingFieldsError listing them; set them together with the type with UpdateIssue.
+func (t *TrackerClient) ChangeIssueType(issueKey, typeKey string) (*Issue, error) {
+	issue, _, err := t.UpdateIssue(issueKey, &UpdateIssueOptions{Type: &typeKey})
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && len(apiErr.FieldErrors) > 0 &&
+		(apiErr.StatusCode == http.StatusBadRequest || apiErr.StatusCode == http.StatusUnprocessableEntity) {
+		fields := make([]string, 0, len(apiErr.FieldErrors))
+		for field := range apiErr.FieldErrors {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		return nil, &MissingFieldsError{Fields: fields, Err: apiErr}
+	}
+
+	return issue, err
+}
+
+// SetDatesOptions changes the start and end dates of an issue shown on the Gantt chart.
+// Dates left nil and not cleared are kept.
+type SetDatesOptions struct {
+	// Start date of the work on the issue. Only the date is sent, in the YYYY-MM-DD format.
+	Start *time.Time
+
+	// Remove the start date.
+	ClearStart bool
+
+	// End date of the work on the issue. Only the date is sent, in the YYYY-MM-DD format.
+	End *time.Time
+
+	// Remove the end date.
+	ClearEnd bool
+}
+
+// SetDates
+// Set or clear the start and end dates of the issue. Options that change neither date are rejected as a no-op update.
+func (t *TrackerClient) SetDates(issueKey string, opts *SetDatesOptions) (*Issue, error) {
+	if opts == nil {
+		return nil, &ValidationError{Field: "options", Reason: "must set at least one date"}
+	}
+	fields := make(map[string]interface{}, 2)
+	switch {
+	case opts.ClearStart:
+		fields["start"] = nil
+	case opts.Start != nil:
+		fields["start"] = opts.Start.Format(queryDateLayout)
+	}
+	switch {
+	case opts.ClearEnd:
+		fields["end"] = nil
+	case opts.End != nil:
+		fields["end"] = opts.End.Format(queryDateLayout)
+	}
+	issue, _, err := t.UpdateIssue(issueKey, &UpdateIssueOptions{Fields: fields})
+
+	return issue, err
+}
+
+// SetUserField
+// Replace the users in a user field, e.g. a reviewers local field, with logins. No logins clears the field.
+func (t *TrackerClient) SetUserField(issueKey, fieldKey string, logins []string) (*Issue, error) {
+	if logins == nil {
+		logins = []string{}
+	}
+	issue, _, err := t.UpdateIssue(issueKey, &UpdateIssueOptions{Fields: map[string]interface{}{fieldKey: logins}})
+
+	return issue, err
+}
+
+// SetTags
+// Make the issue tags equal to tags, trimmed and without duplicates, sending only the tags to add and to remove
+// so that unchanged tags leave no changelog entry. No update is made if the tags already match.
+// Returns the resulting tags of the issue.
+func (t *TrackerClient) SetTags(issueKey string, tags []string) ([]string, error) {
+	wanted := make(map[string]bool, len(tags))
+	var ordered []string
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag != "" && !wanted[tag] {
+			wanted[tag] = true
+			ordered = append(ordered, tag)
+		}
+	}
+
+	issue, _, err := t.GetIssueFields(issueKey, []string{"tags"})
+	if err != nil {
+		return nil, err
+	}
+	current := make(map[string]bool, len(issue.Tags))
+	update := &ListUpdate{}
+	for _, tag := range issue.Tags {
+		current[tag] = true
+		if !wanted[tag] {
+			update.Remove = append(update.Remove, tag)
+		}
+	}
+	for _, tag := range ordered {
+		if !current[tag] {
+			update.Add = append(update.Add, tag)
+		}
+	}
+	if len(update.Add) == 0 && len(update.Remove) == 0 {
+		return issue.Tags, nil
+	}
+
+	updated, _, err := t.UpdateIssue(issueKey, &UpdateIssueOptions{Fields: map[string]interface{}{"tags": update.value()}})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated.Tags, nil
+}
+
+// UpsertIssue
+// Create the issue with the unique value uniqueKey or, if an issue with it already exists, apply update to it.
+// Reports true if the issue was created. A nil update returns the existing issue unchanged.
+func (t *TrackerClient) UpsertIssue(
+	uniqueKey string, opts *CreateIssueOptions, update *UpdateIssueOptions,
+) (*Issue, bool, error) {
+	if uniqueKey == "" {
+		return nil, false, &ValidationError{Field: "unique key", Reason: "must not be empty"}
+	}
+
+	withUnique := CreateIssueOptions{}
+	if opts != nil {
+		withUnique = *opts
+	}
+	withUnique.Unique = &uniqueKey
+	issue, _, err := t.CreateIssue(&withUnique)
+	if err == nil {
+		return issue, true, nil
+	}
+	if !errors.Is(err, ErrConflict) {
+		return nil, false, err
+	}
+
+	found, _, err := t.FindIssues(&FindIssuesOptions{Filter: map[string]interface{}{"unique": uniqueKey}}, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(found) == 0 {
+		return nil, false, fmt.Errorf("issue with unique value %q: %w", uniqueKey, ErrNotFound)
+	}
+	if update == nil {
+		return found[0], false, nil
+	}
+
+	issue, _, err = t.UpdateIssue(found[0].Key, update)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return issue, false, nil
+}
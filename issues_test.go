@@ -0,0 +1,355 @@
+package tracker
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+const testQueueFields = `[
+	{"id":"summary","key":"summary","schema":{"type":"string","required":true}},
+	{"id":"status","key":"status","readonly":true,"schema":{"type":"status","required":true}},
+	{"id":"abc123--myLocalField","key":"myLocalField","schema":{"type":"string","required":true},
+	 "optionsProvider":{"type":"FixedListOptionsProvider","values":["a","b"]}}
+]`
+
+func TestCreateIssueValidatedLocalFields(t *testing.T) {
+	var created map[string]interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v2/queues/TEST/fields":
+			_, _ = w.Write([]byte(testQueueFields))
+		case r.Method == http.MethodPost && r.URL.Path == "/v2/issues/":
+			body, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(body, &created); err != nil {
+				t.Errorf("decode body: %v", err)
+			}
+			_, _ = w.Write([]byte(`{"key":"TEST-1"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	opts := &CreateIssueOptions{Queue: "TEST", Summary: stringPtr("s")}
+	_, err := client.CreateIssueValidated(opts)
+	var errs ValidationErrors
+	if !errors.As(err, &errs) || len(errs) != 1 || errs[0].Field != "myLocalField" {
+		t.Fatalf("err = %v, want myLocalField is required", err)
+	}
+
+	opts.Fields = map[string]interface{}{"myLocalField": "c"}
+	if _, err := client.CreateIssueValidated(opts); !errors.As(err, &errs) || errs[0].Field != "myLocalField" {
+		t.Fatalf("err = %v, want myLocalField value error", err)
+	}
+
+	opts.Fields = map[string]interface{}{"abc123--myLocalField": "c"}
+	if _, err := client.CreateIssueValidated(opts); !errors.As(err, &errs) || errs[0].Field != "abc123--myLocalField" {
+		t.Fatalf("err = %v, want abc123--myLocalField value error", err)
+	}
+
+	opts.Fields = map[string]interface{}{"myLocalField": "a", "summary": "ignored"}
+	issue, err := client.CreateIssueValidated(opts)
+	if err != nil {
+		t.Fatalf("CreateIssueValidated: %v", err)
+	}
+	if issue.Key != "TEST-1" {
+		t.Errorf("key = %q", issue.Key)
+	}
+	want := map[string]interface{}{"queue": "TEST", "summary": "s", "myLocalField": "a"}
+	if len(created) != len(want) {
+		t.Errorf("body = %v, want %v", created, want)
+	}
+	for key, value := range want {
+		if created[key] != value {
+			t.Errorf("body[%s] = %v, want %v", key, created[key], value)
+		}
+	}
+}
+
+func TestUpdateIssueListUpdate(t *testing.T) {
+	var bodies []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		_, _ = w.Write([]byte(`{"key":"TEST-1"}`))
+	})
+
+	_, _, err := client.UpdateIssue("TEST-1", &UpdateIssueOptions{FollowingMaillists: &ListUpdate{}})
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Errorf("err = %v, want ValidationError for an empty list update", err)
+	}
+
+	opts := &UpdateIssueOptions{Summary: stringPtr("s"), FollowingMaillists: &ListUpdate{}}
+	if _, _, err := client.UpdateIssue("TEST-1", opts); err != nil {
+		t.Fatalf("UpdateIssue: %v", err)
+	}
+	opts = &UpdateIssueOptions{FollowingMaillists: &ListUpdate{Add: []string{"team@example.com"}}}
+	if _, _, err := client.UpdateIssue("TEST-1", opts); err != nil {
+		t.Fatalf("UpdateIssue: %v", err)
+	}
+
+	want := []string{`{"summary":"s"}`, `{"followingMaillists":{"add":["team@example.com"]}}`}
+	if len(bodies) != len(want) {
+		t.Fatalf("bodies = %q, want %q", bodies, want)
+	}
+	for i := range want {
+		if bodies[i] != want[i] {
+			t.Errorf("body %d = %s, want %s", i, bodies[i], want[i])
+		}
+	}
+}
+
+func TestSetDates(t *testing.T) {
+	var bodies []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/v2/issues/TEST-1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		_, _ = w.Write([]byte(`{"key":"TEST-1"}`))
+	})
+
+	start := time.Date(2024, 3, 1, 15, 0, 0, 0, time.UTC)
+	for _, opts := range []*SetDatesOptions{
+		{Start: &start},
+		{ClearEnd: true},
+		{Start: &start, End: &start, ClearEnd: true},
+	} {
+		if _, err := client.SetDates("TEST-1", opts); err != nil {
+			t.Fatalf("SetDates: %v", err)
+		}
+	}
+	if _, err := client.SetDates("TEST-1", &SetDatesOptions{}); err == nil {
+		t.Error("SetDates without dates succeeded")
+	}
+
+	want := []string{`{"start":"2024-03-01"}`, `{"end":null}`, `{"end":null,"start":"2024-03-01"}`}
+	if len(bodies) != len(want) {
+		t.Fatalf("bodies = %q, want %q", bodies, want)
+	}
+	for i := range want {
+		if bodies[i] != want[i] {
+			t.Errorf("body %d = %s, want %s", i, bodies[i], want[i])
+		}
+	}
+}
+
+func TestUpdateIssueOptionsClearField(t *testing.T) {
+	opts := &UpdateIssueOptions{
+		Summary: stringPtr("s"),
+		Fields:  map[string]interface{}{"storyPoints": 3},
+	}
+	opts.ClearField("reviewers").ClearField("deadline")
+
+	data, err := json.Marshal(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var body map[string]interface{}
+	if err := json.Unmarshal(data, &body); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{"summary": "s", "storyPoints": float64(3), "reviewers": nil, "deadline": nil}
+	if len(body) != len(want) {
+		t.Fatalf("body = %s, want %v", data, want)
+	}
+	for key, value := range want {
+		got, ok := body[key]
+		if !ok || got != value {
+			t.Errorf("body[%s] = %v, want %v", key, got, value)
+		}
+	}
+}
+
+func TestUpdateIssueRejectsConflictingFields(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL)
+	})
+
+	opts := (&UpdateIssueOptions{Summary: stringPtr("s")}).ClearField("summary")
+	_, _, err := client.UpdateIssue("TEST-1", opts)
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Field != "summary" {
+		t.Errorf("err = %v, want ValidationError for summary", err)
+	}
+}
+
+func TestSortKeysMarshal(t *testing.T) {
+	tests := []struct {
+		keys SortKeys
+		want string
+	}{
+		{keys: SortKeys{{Field: "updatedAt", Desc: true}}, want: `"-updatedAt"`},
+		{keys: SortKeys{{Field: "priority", Desc: true}, {Field: "key"}}, want: `["-priority","+key"]`},
+	}
+	for _, tt := range tests {
+		data, err := json.Marshal(tt.keys)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != tt.want {
+			t.Errorf("Marshal(%v) = %s, want %s", tt.keys, data, tt.want)
+		}
+	}
+}
+
+func TestFindIssuesOrder(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v2/issues/_search" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		if r.URL.RawQuery != "page=2&perPage=10" {
+			t.Errorf("query = %s", r.URL.RawQuery)
+		}
+		body, _ := io.ReadAll(r.Body)
+		if want := `{"filter":{"queue":"TEST"},"order":["-priority","+key"]}`; string(body) != want {
+			t.Errorf("body = %s, want %s", body, want)
+		}
+		_, _ = w.Write([]byte(`[{"key":"TEST-2"},{"key":"TEST-1"}]`))
+	})
+
+	opts := &FindIssuesOptions{
+		Filter: map[string]interface{}{"queue": "TEST"},
+		Order:  SortKeys{{Field: "priority", Desc: true}, {Field: "key"}},
+	}
+	issues, _, err := client.FindIssues(opts, &ListOptions{PerPage: 10, Page: 2})
+	if err != nil {
+		t.Fatalf("FindIssues: %v", err)
+	}
+	if len(issues) != 2 || issues[0].Key != "TEST-2" {
+		t.Errorf("issues = %+v", issues)
+	}
+
+	opts.Query = stringPtr("Queue: TEST")
+	if _, _, err := client.FindIssues(opts, nil); err == nil {
+		t.Error("FindIssues with both Filter and Query succeeded")
+	}
+}
+
+func TestCreateIssueUnique(t *testing.T) {
+	created := make(map[string]bool)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v2/issues/" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		var body struct {
+			Unique string `json:"unique"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Unique == "" {
+			t.Errorf("body has no unique value: %v", err)
+		}
+		if created[body.Unique] {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"errorMessages":["Issue already exists"]}`))
+			return
+		}
+		created[body.Unique] = true
+		_, _ = w.Write([]byte(`{"key":"TEST-1"}`))
+	})
+
+	opts := &CreateIssueOptions{Queue: "TEST", Summary: stringPtr("s"), Unique: stringPtr("event-42")}
+	issue, _, err := client.CreateIssue(opts)
+	if err != nil {
+		t.Fatalf("CreateIssue: %v", err)
+	}
+	if issue.Key != "TEST-1" {
+		t.Errorf("key = %q", issue.Key)
+	}
+
+	if _, _, err := client.CreateIssue(opts); !errors.Is(err, ErrConflict) {
+		t.Errorf("second CreateIssue err = %v, want ErrConflict", err)
+	}
+	if len(created) != 1 {
+		t.Errorf("created = %v", created)
+	}
+}
+
+func TestCreateIssueEstimation(t *testing.T) {
+	var estimation, originalEstimation interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /v2/issues/":
+			body, _ := io.ReadAll(r.Body)
+			var fields map[string]interface{}
+			if err := json.Unmarshal(body, &fields); err != nil {
+				t.Fatal(err)
+			}
+			if fields["originalEstimation"] != "P1W" || fields["estimation"] != "P2DT4H" {
+				t.Errorf("body = %s", body)
+			}
+			estimation, originalEstimation = fields["estimation"], fields["originalEstimation"]
+			_, _ = w.Write([]byte(`{"key":"TEST-1"}`))
+		case "GET /v2/issues/TEST-1":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"key":                "TEST-1",
+				"estimation":         estimation,
+				"originalEstimation": originalEstimation,
+			})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	_, _, err := client.CreateIssue(&CreateIssueOptions{
+		Queue:              "TEST",
+		Summary:            stringPtr("s"),
+		OriginalEstimation: &TrackerDuration{40 * time.Hour},
+		Estimation:         &TrackerDuration{20 * time.Hour},
+	})
+	if err != nil {
+		t.Fatalf("CreateIssue: %v", err)
+	}
+
+	issue, _, err := client.GetIssue("TEST-1")
+	if err != nil {
+		t.Fatalf("GetIssue: %v", err)
+	}
+	if issue.OriginalEstimation == nil || issue.OriginalEstimation.Duration != 40*time.Hour ||
+		issue.Estimation == nil || issue.Estimation.Duration != 20*time.Hour {
+		t.Errorf("estimation = %v, %v", issue.OriginalEstimation, issue.Estimation)
+	}
+}
+
+func TestValidateIssueKey(t *testing.T) {
+	for _, key := range []string{"TEST-1", "queue2-15", "593cd211ef7e8a332414f2a7"} {
+		if err := ValidateIssueKey(key); err != nil {
+			t.Errorf("ValidateIssueKey(%q): %v", key, err)
+		}
+	}
+	for _, key := range []string{"", "TEST", "DEAD", "ABC", "123", "TEST-", "-1", "593CD211EF7E8A332414F2A7", "593cd211ef7e8a33"} {
+		if err := ValidateIssueKey(key); err == nil {
+			t.Errorf("ValidateIssueKey(%q) succeeded", key)
+		}
+	}
+}
+
+func TestCreateIssueKeyFromID(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /v2/issues/":
+			w.Header().Set("Location", "/v2/queues/DEAD")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"593cd211ef7e8a332414f2a7"}`))
+		case "GET /v2/issues/593cd211ef7e8a332414f2a7":
+			_, _ = w.Write([]byte(`{"id":"593cd211ef7e8a332414f2a7","key":"DEAD-1"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	issue, _, err := client.CreateIssue(&CreateIssueOptions{Queue: "DEAD", Summary: stringPtr("s")})
+	if err != nil {
+		t.Fatalf("CreateIssue: %v", err)
+	}
+	if issue.Key != "DEAD-1" {
+		t.Errorf("key = %q, want DEAD-1 fetched by the ID", issue.Key)
+	}
+}
@@ -0,0 +1,80 @@
+package tracker
+
+import (
+	"fmt"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// IssueLink structure in Yandex.Tracker
+// https://cloud.yandex.ru/en/docs/tracker/concepts/issues/get-links
+type IssueLink struct {
+	// Address of the API resource with information about the link.
+	Self string `json:"self"`
+
+	// Link ID.
+	ID int64 `json:"id"`
+
+	// Object with information about the link type.
+	Type *LinkType `json:"type"`
+
+	// Link direction: inward or outward.
+	Direction string `json:"direction"`
+
+	// Object with information about the linked issue.
+	Object *BasicIssue `json:"object"`
+
+	// Object with information about the user who created the link.
+	CreatedBy *BasicUser `json:"createdBy"`
+
+	// Object with information about the user who edited the link last.
+	UpdatedBy *BasicUser `json:"updatedBy"`
+
+	// Link creation date and time.
+	CreatedAt TrackerTime `json:"createdAt"`
+
+	// Date and time when the link was last updated.
+	UpdatedAt TrackerTime `json:"updatedAt"`
+}
+
+type LinkType struct {
+	// Address of the API resource with information about the link type.
+	Self string `json:"self"`
+
+	// Link type ID, for example relates, depends or subtask.
+	ID string `json:"id"`
+
+	// Link name displayed for the inward direction.
+	Inward string `json:"inward"`
+
+	// Link name displayed for the outward direction.
+	Outward string `json:"outward"`
+}
+
+func (t *TrackerClient) GetLinks(issueKey string) ([]*IssueLink, *resty.Response, error) {
+	req := t.NewRequest(resty.MethodGet, "/v2/issues/"+issueKey+"/links", nil)
+	var result []*IssueLink
+	resp, err := t.Do(req, &result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+// LinkIssues
+// Link the issue to another one. Relationship is the link type from the issue's side, e.g. relates or depends on.
+// https://cloud.yandex.ru/en/docs/tracker/concepts/issues/link-issue
+func (t *TrackerClient) LinkIssues(issueKey, relationship, otherKey string) (*IssueLink, *resty.Response, error) {
+	req := t.NewRequest(resty.MethodPost, "/v2/issues/"+issueKey+"/links", map[string]string{
+		"relationship": relationship,
+		"issue":        otherKey,
+	})
+	result := new(IssueLink)
+	resp, err := t.Do(req, result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
@@ -0,0 +1,57 @@
+package tracker
+
+import "net/http"
+
+// Handler sends an HTTP request and returns its response, like http.RoundTripper.
+type Handler func(req *http.Request) (*http.Response, error)
+
+// Middleware wraps a Handler to act before a request is sent and after its response is received,
+// e.g. to log, measure or add headers. It must call next to send the request.
+type Middleware func(next Handler) Handler
+
+// handlerTransport adapts a Handler to http.RoundTripper.
+type handlerTransport Handler
+
+func (h handlerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return h(req)
+}
+
+// WithMiddleware
+// Wrap every HTTP request the client sends, including each retry attempt, with middlewares.
+// Middlewares run in the order they are added: the first one added sees the request first and the response last.
+func (t *TrackerClient) WithMiddleware(middlewares ...Middleware) {
+	t.middlewares = append(t.middlewares, middlewares...)
+	t.setTransport(t.baseTransport())
+}
+
+// baseTransport
+// Get the transport requests go through after all middlewares
+func (t *TrackerClient) baseTransport() http.RoundTripper {
+	if t.transport != nil {
+		return t.transport
+	}
+	if transport := t.client.GetClient().Transport; transport != nil {
+		return transport
+	}
+
+	return http.DefaultTransport
+}
+
+// setTransport
+// Install the transport wrapped with the middlewares
+func (t *TrackerClient) setTransport(transport http.RoundTripper) {
+	t.transport = transport
+	handler := Handler(transport.RoundTrip)
+	for i := len(t.middlewares) - 1; i >= 0; i-- {
+		handler = t.middlewares[i](handler)
+	}
+	t.client.SetTransport(handlerTransport(handler))
+}
+
+// WithResponseMiddleware
+// Call fn with every value Do decodes a response into, e.g. *Issue or *[]*Issue, to clean up the data
+// in one place, such as trimming summaries. Hooks run in the order they are added after decoding succeeds;
+// responses decoded element by element with DoStream are not passed to them.
+func (t *TrackerClient) WithResponseMiddleware(fn func(v interface{})) {
+	t.responseHooks = append(t.responseHooks, fn)
+}
@@ -0,0 +1,25 @@
+package tracker
+
+import (
+	"strconv"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// NotifyOptions control email notifications sent by mutating requests.
+// A nil *NotifyOptions keeps the API defaults, where notifications are sent.
+type NotifyOptions struct {
+	// Notify users specified in the issue fields.
+	Notify bool
+
+	// Notify the author of the change.
+	NotifyAuthor bool
+}
+
+func (o *NotifyOptions) apply(req *resty.Request) {
+	if o == nil {
+		return
+	}
+	req.SetQueryParam("notify", strconv.FormatBool(o.Notify))
+	req.SetQueryParam("notifyAuthor", strconv.FormatBool(o.NotifyAuthor))
+}
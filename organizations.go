@@ -0,0 +1,36 @@
+package tracker
+
+import "fmt"
+
+// Organization the client operates on.
+// Tracker has no dedicated organization endpoint, so the data is derived from
+// the organization headers and the current user.
+type Organization struct {
+	// Organization ID passed in the X-Org-Id or X-Cloud-Org-ID header.
+	ID string
+
+	// Flag indicating the organization type:
+	// true: Yandex Cloud Organization
+	// false: Yandex 360 for Business organization
+	Cloud bool
+
+	// User account the token resolves to in the organization.
+	User *User
+}
+
+func (t *TrackerClient) GetOrganization() (*Organization, error) {
+	user, err := t.Myself()
+	if err != nil {
+		return nil, fmt.Errorf("myself: %w", err)
+	}
+
+	org := &Organization{User: user}
+	if id, ok := t.headers["X-Cloud-Org-ID"]; ok {
+		org.ID = id
+		org.Cloud = true
+	} else {
+		org.ID = t.headers["X-Org-Id"]
+	}
+
+	return org, nil
+}
@@ -0,0 +1,86 @@
+package tracker
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Links
+// Get the URLs from the RFC 5988 Link header of the response keyed by relation,
+// e.g. links["next"] holds the URL of the next page
+func Links(resp *resty.Response) map[string]string {
+	if resp == nil {
+		return map[string]string{}
+	}
+
+	return parseLink(resp.Header().Get("Link"))
+}
+
+// TotalCount
+// Get the total number of objects matching a listing, e.g. FindIssues, from the X-Total-Count header.
+// Returns -1 if the response has no such header.
+func TotalCount(resp *resty.Response) int {
+	if resp == nil {
+		return -1
+	}
+	total, err := strconv.Atoi(resp.Header().Get("X-Total-Count"))
+	if err != nil {
+		return -1
+	}
+
+	return total
+}
+
+// parseLink
+// Parse an RFC 5988 Link header such as `<url1>; rel="next", <url2>; rel="first"`.
+// A link with several space separated relations is stored under each of them.
+func parseLink(header string) map[string]string {
+	links := make(map[string]string)
+	for _, link := range strings.Split(header, ",") {
+		parts := strings.Split(link, ";")
+		target := strings.TrimSpace(parts[0])
+		if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+			continue
+		}
+		target = target[1 : len(target)-1]
+
+		for _, param := range parts[1:] {
+			name, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if !ok || !strings.EqualFold(strings.TrimSpace(name), "rel") {
+				continue
+			}
+			for _, rel := range strings.Fields(strings.Trim(strings.TrimSpace(value), `"`)) {
+				links[strings.ToLower(rel)] = target
+			}
+		}
+	}
+
+	return links
+}
+
+// hasMore
+// Report whether a listing has more pages after the current one.
+// The Link header is used when present, otherwise a short page means the last one.
+func hasMore(resp *resty.Response, perPage, got int) bool {
+	if links := Links(resp); len(links) > 0 {
+		_, ok := links["next"]
+		return ok
+	}
+
+	return got >= perPage && got > 0
+}
+
+// hasNextPage
+// Report whether a page-numbered listing has more pages after page.
+// X-Total-Pages is used when present, otherwise the same rules as hasMore apply.
+func hasNextPage(resp *resty.Response, page, perPage, got int) bool {
+	if resp != nil {
+		if total, err := strconv.Atoi(resp.Header().Get("X-Total-Pages")); err == nil {
+			return page < total
+		}
+	}
+
+	return hasMore(resp, perPage, got)
+}
@@ -0,0 +1,76 @@
+package tracker
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestParseLink(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   map[string]string
+	}{
+		{name: "empty", header: "", want: map[string]string{}},
+		{
+			name:   "several links",
+			header: `<https://api.tracker.yandex.net/v2/issues?page=2>; rel="next", <https://api.tracker.yandex.net/v2/issues?page=1>; rel="first"`,
+			want: map[string]string{
+				"next":  "https://api.tracker.yandex.net/v2/issues?page=2",
+				"first": "https://api.tracker.yandex.net/v2/issues?page=1",
+			},
+		},
+		{
+			name:   "several relations of one link",
+			header: `</v2/issues?page=3>; rel="next last"`,
+			want:   map[string]string{"next": "/v2/issues?page=3", "last": "/v2/issues?page=3"},
+		},
+		{
+			name:   "other params, unquoted and upper case rel",
+			header: `</a>; title="A"; REL=Prev, <broken; rel="next"`,
+			want:   map[string]string{"prev": "/a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseLink(tt.header)
+			if len(got) != len(tt.want) {
+				t.Fatalf("parseLink = %v, want %v", got, tt.want)
+			}
+			for rel, target := range tt.want {
+				if got[rel] != target {
+					t.Errorf("%s = %q, want %q", rel, got[rel], target)
+				}
+			}
+		})
+	}
+}
+
+func TestGetAllCommentsFollowsLink(t *testing.T) {
+	var queries []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/v2/issues/TEST-1/comments" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		queries = append(queries, r.URL.RawQuery)
+		if r.URL.Query().Get("id") == "" {
+			w.Header().Set("Link", `</v2/issues/TEST-1/comments?id=2>; rel="next", </v2/issues/TEST-1/comments>; rel="first"`)
+			_, _ = w.Write([]byte(`[{"id":1},{"id":2}]`))
+			return
+		}
+		w.Header().Set("Link", `</v2/issues/TEST-1/comments>; rel="first"`)
+		_, _ = w.Write([]byte(`[{"id":3}]`))
+	})
+
+	comments, err := client.GetAllComments("TEST-1")
+	if err != nil {
+		t.Fatalf("GetAllComments: %v", err)
+	}
+	if len(comments) != 3 || comments[2].ID != 3 {
+		t.Errorf("comments = %+v", comments)
+	}
+	want := []string{"perPage=100", "id=2&perPage=100"}
+	if len(queries) != len(want) || queries[0] != want[0] || queries[1] != want[1] {
+		t.Errorf("queries = %q, want %q", queries, want)
+	}
+}
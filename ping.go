@@ -0,0 +1,21 @@
+package tracker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Ping
+// Check connectivity and authorization by requesting the current user.
+// Authorization failures match ErrUnauthorized or ErrForbidden with errors.Is,
+// any other error means Tracker could not be reached or answered with an unexpected status.
+func (t *TrackerClient) Ping(ctx context.Context) error {
+	req := t.NewRequest(resty.MethodGet, "/v2/myself", nil).SetContext(ctx)
+	if _, err := t.Do(req, new(User)); err != nil {
+		return fmt.Errorf("ping: %w", err)
+	}
+
+	return nil
+}
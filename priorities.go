@@ -1,5 +1,12 @@
 package tracker
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
 // BasicPriority
 // https://cloud.yandex.ru/en/docs/tracker/concepts/issues/get-issue#priority
 type BasicPriority struct {
@@ -38,3 +45,64 @@ type Priority struct {
 	// Priority weight. This parameter affects the order of priority display in the interface.
 	Order int `json:"order"`
 }
+
+func (t *TrackerClient) GetPriorities() ([]*Priority, *resty.Response, error) {
+	req := t.NewRequest(resty.MethodGet, "/v2/priorities", nil)
+	var result []*Priority
+	resp, err := t.Do(req, &result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+// cachedPriorities
+// Get the priorities, fetching them once for the lifetime of the client
+func (t *TrackerClient) cachedPriorities() ([]*Priority, error) {
+	t.prioritiesMu.Lock()
+	defer t.prioritiesMu.Unlock()
+	if t.priorities != nil {
+		return t.priorities, nil
+	}
+
+	priorities, _, err := t.GetPriorities()
+	if err != nil {
+		return nil, err
+	}
+	t.priorities = priorities
+
+	return priorities, nil
+}
+
+// SetPriority
+// Set the issue priority by key, e.g. critical, checking first that such a priority exists.
+// A display name given instead of the key is rejected with a hint at the right key.
+func (t *TrackerClient) SetPriority(issueKey, priorityKey string) (*Issue, error) {
+	priorities, err := t.cachedPriorities()
+	if err != nil {
+		return nil, err
+	}
+
+	var known bool
+	for _, priority := range priorities {
+		if priority.Key == priorityKey {
+			known = true
+			break
+		}
+	}
+	if !known {
+		reason := fmt.Sprintf("%q is not a priority key", priorityKey)
+		for _, priority := range priorities {
+			if strings.EqualFold(priority.Name, priorityKey) {
+				reason += fmt.Sprintf(", use the key %q", priority.Key)
+				break
+			}
+		}
+		return nil, &ValidationError{Field: "priority", Reason: reason}
+	}
+
+	issue, _, err := t.UpdateIssue(issueKey, &UpdateIssueOptions{Priority: &priorityKey})
+
+	return issue, err
+}
@@ -0,0 +1,143 @@
+package tracker
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Operators of QueryBuilder clauses.
+const (
+	OpEqual    = "="
+	OpNotEqual = "!="
+	OpGreater  = ">"
+	OpLess     = "<"
+	OpChanged  = "changed()"
+)
+
+// QueryBuilder composes filters in the Tracker query language.
+// Clauses added to one builder are joined with AND; combine builders with And and Or.
+// https://cloud.yandex.ru/en/docs/tracker/user/query-filter
+type QueryBuilder struct {
+	clauses []string
+	sort    []string
+	err     error
+}
+
+func NewQueryBuilder() *QueryBuilder {
+	return &QueryBuilder{}
+}
+
+// Queue
+// Match issues of the queue
+func (b *QueryBuilder) Queue(queueKey string) *QueryBuilder {
+	return b.CustomField("Queue", OpEqual, queueKey)
+}
+
+// CreatedBy
+// Match issues created by the user
+func (b *QueryBuilder) CreatedBy(login string) *QueryBuilder {
+	return b.CustomField("Created By", OpEqual, login)
+}
+
+// UpdatedBy
+// Match issues last updated by the user
+func (b *QueryBuilder) UpdatedBy(login string) *QueryBuilder {
+	return b.CustomField("Updated By", OpEqual, login)
+}
+
+// CustomField
+// Add a clause comparing the field with the value, e.g. CustomField("Team", OpEqual, "Backend").
+// With OpChanged the clause matches issues where the field was changed to value, or changed at all if value is empty.
+func (b *QueryBuilder) CustomField(key, op, value string) *QueryBuilder {
+	var condition string
+	switch op {
+	case OpEqual:
+		condition = quoteQueryValue(value)
+	case OpNotEqual:
+		condition = "!" + quoteQueryValue(value)
+	case OpGreater, OpLess:
+		condition = op + " " + quoteQueryValue(value)
+	case OpChanged:
+		condition = "changed()"
+		if value != "" {
+			condition = "changed(to: " + quoteQueryValue(value) + ")"
+		}
+	default:
+		if b.err == nil {
+			b.err = fmt.Errorf("unsupported operator %q for field %s", op, key)
+		}
+		return b
+	}
+
+	b.clauses = append(b.clauses, quoteQueryKey(key)+": "+condition)
+	return b
+}
+
+// SortBy
+// Sort the results by the field, ascending unless desc is set. Several calls sort by several fields.
+func (b *QueryBuilder) SortBy(key string, desc bool) *QueryBuilder {
+	direction := "ASC"
+	if desc {
+		direction = "DESC"
+	}
+	b.sort = append(b.sort, quoteQueryKey(key)+" "+direction)
+	return b
+}
+
+// And
+// Require the conditions of other in addition to the conditions of b
+func (b *QueryBuilder) And(other *QueryBuilder) *QueryBuilder {
+	return b.combine("AND", other)
+}
+
+// Or
+// Match issues satisfying either the conditions of b or the conditions of other
+func (b *QueryBuilder) Or(other *QueryBuilder) *QueryBuilder {
+	return b.combine("OR", other)
+}
+
+func (b *QueryBuilder) combine(operator string, other *QueryBuilder) *QueryBuilder {
+	if b.err == nil {
+		b.err = other.err
+	}
+	left, right := b.condition(), other.condition()
+	switch {
+	case right == "":
+	case left == "":
+		b.clauses = []string{right}
+	default:
+		b.clauses = []string{"(" + left + ") " + operator + " (" + right + ")"}
+	}
+	b.sort = append(b.sort, other.sort...)
+	return b
+}
+
+func (b *QueryBuilder) condition() string {
+	return strings.Join(b.clauses, " AND ")
+}
+
+// Build
+// Get the query text, or the first error made while composing it
+func (b *QueryBuilder) Build() (string, error) {
+	if b.err != nil {
+		return "", b.err
+	}
+
+	query := b.condition()
+	if len(b.sort) > 0 {
+		query = strings.TrimSpace(query + ` "Sort by": ` + strings.Join(b.sort, ", "))
+	}
+	return query, nil
+}
+
+// quoteQueryKey
+// Quote a field key that contains characters other than ASCII letters, digits, dots and underscores
+func quoteQueryKey(key string) string {
+	for _, r := range key {
+		if !(r == '.' || r == '_' || r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z') {
+			return quoteQueryValue(key)
+		}
+	}
+
+	return key
+}
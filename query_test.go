@@ -0,0 +1,70 @@
+package tracker
+
+import (
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestQuoteQueryKey(t *testing.T) {
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{key: "Team", want: "Team"},
+		{key: "myQueue.team_2", want: "myQueue.team_2"},
+		{key: "Created By", want: `"Created By"`},
+		{key: "Команда", want: `"Команда"`},
+		{key: `Say "hi"`, want: `"Say \"hi\""`},
+		{key: `back\slash`, want: `"back\\slash"`},
+		{key: "a-b", want: `"a-b"`},
+	}
+	for _, tt := range tests {
+		if got := quoteQueryKey(tt.key); got != tt.want {
+			t.Errorf("quoteQueryKey(%q) = %s, want %s", tt.key, got, tt.want)
+		}
+	}
+}
+
+func TestQueryBuilderCustomField(t *testing.T) {
+	query, err := NewQueryBuilder().
+		CustomField("Team", OpEqual, "Back end").
+		CustomField("Story Points", OpGreater, "3").
+		CustomField("Title", OpNotEqual, `say "hi"`).
+		CustomField("Status", OpChanged, "").
+		SortBy("Updated", true).
+		Build()
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	want := `Team: "Back end" AND "Story Points": > "3" AND Title: !"say \"hi\"" AND Status: changed() "Sort by": Updated DESC`
+	if query != want {
+		t.Errorf("query = %s\nwant    %s", query, want)
+	}
+
+	if _, err := NewQueryBuilder().CustomField("Team", "~", "x").Build(); err == nil {
+		t.Error("Build with an unsupported operator succeeded")
+	}
+}
+
+func TestQueryBuilderSearch(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v2/issues/_search" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		body, _ := io.ReadAll(r.Body)
+		if want := `{"query":"\"Created By\": \"alice\""}`; string(body) != want {
+			t.Errorf("body = %s, want %s", body, want)
+		}
+		_, _ = w.Write([]byte(`[{"key":"TEST-1"}]`))
+	})
+
+	query, err := NewQueryBuilder().CreatedBy("alice").Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	issues, _, err := client.FindIssues(&FindIssuesOptions{Query: &query}, nil)
+	if err != nil || len(issues) != 1 {
+		t.Errorf("FindIssues = %v, %v", issues, err)
+	}
+}
@@ -1,5 +1,12 @@
 package tracker
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
 type BasicQueue struct {
 	// Address of the API resource with information about the queue.
 	Self string `json:"self"`
@@ -13,3 +20,219 @@ type BasicQueue struct {
 	// Queue name displayed.
 	Display string `json:"display"`
 }
+
+// Queue structure in Yandex.Tracker
+// https://cloud.yandex.ru/en/docs/tracker/concepts/queues/get-queue
+type Queue struct {
+	// Address of the API resource with information about the queue.
+	Self string `json:"self"`
+
+	// Queue ID.
+	ID int `json:"id"`
+
+	// Queue key.
+	Key string `json:"key"`
+
+	// Queue version. Each change to the queue increases its version number.
+	Version int `json:"version"`
+
+	// Queue name.
+	Name string `json:"name"`
+
+	// Queue description.
+	Description string `json:"description"`
+
+	// Object with information about the queue owner.
+	Lead *BasicUser `json:"lead"`
+
+	// Automatic assignment of new issues to the queue team members.
+	AssignAuto bool `json:"assignAuto"`
+
+	// Object with information about the default issue type.
+	DefaultType *IssueType `json:"defaultType"`
+
+	// Object with information about the default priority.
+	DefaultPriority *BasicPriority `json:"defaultPriority"`
+
+	// Array of objects with information about the queue team members.
+	TeamUsers []*BasicUser `json:"teamUsers"`
+
+	// Array of objects with information about the queue issue types.
+	IssueTypes []*IssueType `json:"issueTypes"`
+
+	// Voting for issues is disabled.
+	DenyVoting bool `json:"denyVoting"`
+
+	// Issue types of the queue keyed by the ID of the workflow they use.
+	// Returned when the queue is requested with expand=workflows.
+	Workflows map[string][]*IssueType `json:"workflows"`
+
+	// Array of objects with the settings of the issue types enabled in the queue.
+	// Returned when the queue is requested with expand=issueTypesConfig.
+	IssueTypesConfig []*IssueTypeConfig `json:"issueTypesConfig"`
+}
+
+type IssueTypeConfig struct {
+	// Object with information about the issue type.
+	IssueType *IssueType `json:"issueType"`
+
+	// Array of objects with information about the resolutions available for the issue type.
+	Resolutions []*BasicResolution `json:"resolutions"`
+}
+
+// GetQueueIssueTypes
+// Get the issue types enabled in the queue, which may be fewer than the issue types of the organization
+func (t *TrackerClient) GetQueueIssueTypes(queueKey string) ([]*IssueType, error) {
+	queue, _, err := t.GetQueue(queueKey, "issueTypesConfig")
+	if err != nil {
+		return nil, err
+	}
+	if queue.IssueTypesConfig == nil {
+		return queue.IssueTypes, nil
+	}
+
+	result := make([]*IssueType, 0, len(queue.IssueTypesConfig))
+	for _, config := range queue.IssueTypesConfig {
+		if config.IssueType != nil {
+			result = append(result, config.IssueType)
+		}
+	}
+
+	return result, nil
+}
+
+// WithQueueAliases
+// Map old queue keys, e.g. from before a queue was renamed, to the current ones.
+// Queue helpers accept the old keys and send the current ones
+func (t *TrackerClient) WithQueueAliases(aliases map[string]string) {
+	t.queueAliases = make(map[string]string, len(aliases))
+	for alias, key := range aliases {
+		t.queueAliases[strings.ToUpper(alias)] = key
+	}
+}
+
+// CanonicalQueueKey
+// Get the current key for a queue key set as an alias with WithQueueAliases, or the key itself
+func (t *TrackerClient) CanonicalQueueKey(queueKey string) string {
+	if key, ok := t.queueAliases[strings.ToUpper(queueKey)]; ok {
+		return key
+	}
+
+	return queueKey
+}
+
+// GetQueue
+// Get queue by key or ID. Expand lists additional fields to include, e.g. issueTypesConfig or all.
+func (t *TrackerClient) GetQueue(queueKey, expand string) (*Queue, *resty.Response, error) {
+	req := t.NewRequest(resty.MethodGet, "/v2/queues/"+t.CanonicalQueueKey(queueKey), nil)
+	if expand != "" {
+		req.SetQueryParam("expand", expand)
+	}
+	result := new(Queue)
+	resp, err := t.Do(req, result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+// Number of queues requested per page by GetMyQueues.
+const queuesPerPage = 100
+
+func (t *TrackerClient) GetQueues(listOpts *ListOptions) ([]*Queue, *resty.Response, error) {
+	req := t.NewRequest(resty.MethodGet, "/v2/queues/", nil)
+	listOpts.apply(req)
+	var result []*Queue
+	resp, err := t.Do(req, &result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+// GetMyQueues
+// Get all queues the current user has access to following pagination.
+// The API only lists queues the token can read; write access is checked when an issue is created.
+func (t *TrackerClient) GetMyQueues() ([]*Queue, error) {
+	listOpts := &ListOptions{PerPage: queuesPerPage, Page: 1}
+	var result []*Queue
+	for {
+		queues, resp, err := t.GetQueues(listOpts)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, queues...)
+		if !hasNextPage(resp, listOpts.Page, listOpts.PerPage, len(queues)) {
+			return result, nil
+		}
+		listOpts.Page++
+	}
+}
+
+// GetQueueFields
+// Get global and local fields available in the queue
+func (t *TrackerClient) GetQueueFields(queueKey string) ([]*Field, *resty.Response, error) {
+	req := t.NewRequest(resty.MethodGet, "/v2/queues/"+t.CanonicalQueueKey(queueKey)+"/fields", nil)
+	var result []*Field
+	resp, err := t.Do(req, &result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+// QueueDefaults are the values to prefill an issue creation form with.
+type QueueDefaults struct {
+	// Default issue type of the queue.
+	DefaultType *IssueType
+
+	// Default priority of the queue.
+	DefaultPriority *BasicPriority
+
+	// Queue fields that must be set when creating an issue.
+	RequiredFields []*Field
+}
+
+func (t *TrackerClient) QueueDefaults(queueKey string) (*QueueDefaults, error) {
+	queue, _, err := t.GetQueue(queueKey, "")
+	if err != nil {
+		return nil, err
+	}
+	fields, _, err := t.GetQueueFields(queueKey)
+	if err != nil {
+		return nil, err
+	}
+
+	defaults := &QueueDefaults{DefaultType: queue.DefaultType, DefaultPriority: queue.DefaultPriority}
+	for _, field := range fields {
+		if field.Schema != nil && field.Schema.Required {
+			defaults.RequiredFields = append(defaults.RequiredFields, field)
+		}
+	}
+
+	return defaults, nil
+}
+
+// WithDefaultQueue
+// Set the queue used by CreateIssue and GetQueueIssues when no queue is given
+func (t *TrackerClient) WithDefaultQueue(queueKey string) {
+	t.defaultQueue = queueKey
+}
+
+// GetQueueIssues
+// Get a page of the queue issues. An empty queueKey means the queue set with WithDefaultQueue.
+func (t *TrackerClient) GetQueueIssues(queueKey string, listOpts *ListOptions) ([]*Issue, *resty.Response, error) {
+	if queueKey == "" {
+		queueKey = t.defaultQueue
+	}
+	if queueKey == "" {
+		return nil, nil, &ValidationError{Field: "queue", Reason: "is required when no default queue is set"}
+	}
+
+	queueKey = t.CanonicalQueueKey(queueKey)
+
+	return t.FindIssues(&FindIssuesOptions{Queue: &queueKey}, listOpts)
+}
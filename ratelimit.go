@@ -0,0 +1,57 @@
+package tracker
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// rateLimiter spaces requests evenly so that at most one starts per interval.
+type rateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+func newRateLimiter(requestsPerSecond int) *rateLimiter {
+	return &rateLimiter{interval: time.Second / time.Duration(requestsPerSecond)}
+}
+
+// wait
+// Block until the next request slot or until ctx is done
+func (l *rateLimiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// WithRateLimit
+// Limit the client to requestsPerSecond requests sent through Do. Zero or a negative value disables the limit.
+func (t *TrackerClient) WithRateLimit(requestsPerSecond int) {
+	if requestsPerSecond <= 0 {
+		t.limiter = nil
+		return
+	}
+	t.limiter = newRateLimiter(requestsPerSecond)
+}
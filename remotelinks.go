@@ -0,0 +1,119 @@
+package tracker
+
+import (
+	"fmt"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// RemoteLink structure in Yandex.Tracker
+// https://cloud.yandex.ru/en/docs/tracker/external-links
+type RemoteLink struct {
+	// Address of the API resource with information about the link.
+	Self string `json:"self"`
+
+	// Link ID.
+	ID int64 `json:"id"`
+
+	// Object with information about the link type.
+	Type *RemoteLinkType `json:"type"`
+
+	// Link direction: inward or outward.
+	Direction string `json:"direction"`
+
+	// Object with information about the linked external object.
+	Object *RemoteObject `json:"object"`
+
+	// Object with information about the user who created the link.
+	CreatedBy *BasicUser `json:"createdBy"`
+
+	// Object with information about the user who edited the link last.
+	UpdatedBy *BasicUser `json:"updatedBy"`
+
+	// Link creation date and time.
+	CreatedAt TrackerTime `json:"createdAt"`
+
+	// Date and time when the link was last updated.
+	UpdatedAt TrackerTime `json:"updatedAt"`
+}
+
+type RemoteLinkType struct {
+	// Link type ID, for example relates.
+	ID string `json:"id"`
+
+	// Link name displayed for the inward direction.
+	Inward string `json:"inward"`
+
+	// Link name displayed for the outward direction.
+	Outward string `json:"outward"`
+}
+
+type RemoteObject struct {
+	// Address of the API resource with information about the object.
+	Self string `json:"self"`
+
+	// Object ID.
+	ID string `json:"id"`
+
+	// Object key in the external application.
+	Key string `json:"key"`
+
+	// Object with information about the external application.
+	Application *RemoteApplication `json:"application"`
+}
+
+type RemoteApplication struct {
+	// Address of the API resource with information about the application.
+	Self string `json:"self"`
+
+	// Application ID, passed as origin when creating a link.
+	ID string `json:"id"`
+
+	// Application type.
+	Type string `json:"type"`
+
+	// Application name displayed.
+	Name string `json:"name"`
+}
+
+// https://cloud.yandex.ru/en/docs/tracker/external-links
+// Tracker links to objects of registered external applications rather than to
+// arbitrary URLs: the URL and title shown in the interface come from the application.
+type RemoteLinkOptions struct {
+	// Link type, for example relates. Required.
+	Relationship *string `json:"relationship,omitempty"`
+
+	// Object key in the external application, for example a pull request ID. Required.
+	Key *string `json:"key,omitempty"`
+
+	// ID of the external application the object belongs to. Required.
+	Origin *string `json:"origin,omitempty"`
+
+	// Notification settings passed as query parameters.
+	Notify *NotifyOptions `json:"-"`
+}
+
+func (t *TrackerClient) AddRemoteLink(issueKey string, opts *RemoteLinkOptions) (*RemoteLink, *resty.Response, error) {
+	req := t.NewRequest(resty.MethodPost, "/v2/issues/"+issueKey+"/remotelinks", opts)
+	if opts != nil {
+		opts.Notify.apply(req)
+	}
+	result := new(RemoteLink)
+	resp, err := t.Do(req, result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+func (t *TrackerClient) GetRemoteLinks(issueKey string) ([]*RemoteLink, *resty.Response, error) {
+	req := t.NewRequest(resty.MethodGet, "/v2/issues/"+issueKey+"/remotelinks", nil)
+	var result []*RemoteLink
+	resp, err := t.Do(req, &result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
@@ -0,0 +1,22 @@
+package tracker
+
+import "github.com/go-resty/resty/v2"
+
+// Header carrying the ID that correlates a request across systems.
+const requestIDHeader = "X-Request-Id"
+
+// WithRequestIDFunc
+// Set the X-Request-Id header of every request to a value generated by fn, e.g. a UUID or a trace ID,
+// and log it at debug level. A header already set on the request is kept.
+// The ID the API returns is available in APIError.RequestID.
+func (t *TrackerClient) WithRequestIDFunc(fn func() string) {
+	t.client.OnBeforeRequest(func(_ *resty.Client, req *resty.Request) error {
+		if req.Header.Get(requestIDHeader) != "" {
+			return nil
+		}
+		id := fn()
+		req.SetHeader(requestIDHeader, id)
+		t.logger.Debugf("request %s %s %s=%s", req.Method, req.URL, requestIDHeader, id)
+		return nil
+	})
+}
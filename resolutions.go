@@ -0,0 +1,17 @@
+package tracker
+
+// BasicResolution
+// https://cloud.yandex.ru/en/docs/tracker/concepts/issues/get-issue#resolution
+type BasicResolution struct {
+	// Address of the API resource with information about the resolution.
+	Self string `json:"self"`
+
+	// Resolution ID.
+	ID string `json:"id"`
+
+	// Resolution key, e.g. fixed.
+	Key string `json:"key"`
+
+	// Resolution name displayed.
+	Display string `json:"display"`
+}
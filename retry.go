@@ -0,0 +1,116 @@
+package tracker
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// WithRetry
+// Retry failed requests up to count times with exponential backoff between waitTime and maxWaitTime.
+// 429 Too Many Requests is retried for every request. Network errors and 5xx responses are retried for GET, HEAD,
+// PUT and DELETE requests only, as a POST or PATCH may have been applied, e.g. created an issue, before it failed.
+// POST requests creating issues with a unique value are retried too; see WithRetryNonIdempotent for the rest.
+func (t *TrackerClient) WithRetry(count int, waitTime, maxWaitTime time.Duration) {
+	t.client.
+		SetRetryCount(count).
+		SetRetryWaitTime(waitTime).
+		SetRetryMaxWaitTime(maxWaitTime)
+}
+
+// WithRetryCondition
+// Add a condition under which a request is retried in addition to the built-in ones.
+// Conditions are combined with OR and only apply when retries are enabled with WithRetry.
+func (t *TrackerClient) WithRetryCondition(condition func(*resty.Response, error) bool) {
+	t.client.AddRetryCondition(withinRetryBudget(condition))
+}
+
+type retryBudgetKey struct{}
+
+// WithRetryBudget
+// Limit retries of requests sent with ctx to at most retries, e.g. 0 to fail fast on an interactive path,
+// regardless of the count set with WithRetry. Set ctx with NewRequest(...).SetContext or pass it to methods
+// taking a context. The budget cannot raise the client count.
+func WithRetryBudget(ctx context.Context, retries int) context.Context {
+	return context.WithValue(ctx, retryBudgetKey{}, retries)
+}
+
+// withinRetryBudget
+// Wrap the retry condition so it never retries a request that has used up the budget of its context
+func withinRetryBudget(condition resty.RetryConditionFunc) resty.RetryConditionFunc {
+	return func(resp *resty.Response, err error) bool {
+		if resp != nil && resp.Request != nil {
+			if budget, ok := resp.Request.Context().Value(retryBudgetKey{}).(int); ok && resp.Request.Attempt > budget {
+				return false
+			}
+		}
+
+		return condition(resp, err)
+	}
+}
+
+// WithRetryNonIdempotent
+// Retry POST and PATCH requests failed with a network error or a 5xx response too.
+// A retried request may apply its change twice, e.g. add the same comment or worklog again.
+func (t *TrackerClient) WithRetryNonIdempotent(retry bool) {
+	t.retryNonIdempotent = retry
+}
+
+// Methods whose requests can be repeated without changing the result.
+var idempotentMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodPut:     true,
+	http.MethodDelete:  true,
+	http.MethodOptions: true,
+}
+
+func (t *TrackerClient) defaultRetryCondition(resp *resty.Response, err error) bool {
+	if resp == nil || resp.Request == nil || errors.Is(err, resty.ErrResponseBodyTooLarge) {
+		return false
+	}
+	if err == nil && resp.StatusCode() == http.StatusTooManyRequests {
+		return true
+	}
+	if !t.retryNonIdempotent && !idempotentMethods[resp.Request.Method] && !hasIdempotencyKey(resp.Request) {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+
+	return resp.StatusCode() >= http.StatusInternalServerError
+}
+
+// hasIdempotencyKey
+// Report whether the request creates an issue with a unique value, which Tracker refuses to create twice
+func hasIdempotencyKey(req *resty.Request) bool {
+	switch body := req.Body.(type) {
+	case *CreateIssueOptions:
+		return body != nil && (body.Unique != nil || body.Fields["unique"] != nil)
+	case map[string]interface{}:
+		return body["unique"] != nil
+	default:
+		return false
+	}
+}
+
+// WithBeforeRetry
+// Call fn before waiting to retry a failed request, e.g. to log or count retries.
+// Attempt is the number of the attempt that failed, starting at 1; resp and err tell why it failed.
+// The last failed attempt, which is not retried, does not call fn.
+func (t *TrackerClient) WithBeforeRetry(fn func(attempt int, resp *resty.Response, err error)) {
+	t.client.AddRetryHook(func(resp *resty.Response, err error) {
+		attempt := 0
+		if resp != nil && resp.Request != nil {
+			attempt = resp.Request.Attempt
+		}
+		if attempt > t.client.RetryCount {
+			return
+		}
+		fn(attempt, resp, err)
+	})
+}
@@ -0,0 +1,102 @@
+package tracker
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestDefaultRetryCondition(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        int
+		nonIdempotent bool
+		send          func(client *TrackerClient) error
+		wantRequests  int
+	}{
+		{
+			name:   "GET 5xx",
+			status: http.StatusBadGateway,
+			send: func(client *TrackerClient) error {
+				_, _, err := client.GetIssue("TEST-1")
+				return err
+			},
+			wantRequests: 3,
+		},
+		{
+			name:   "POST 5xx",
+			status: http.StatusBadGateway,
+			send: func(client *TrackerClient) error {
+				_, _, err := client.AddComment("TEST-1", &AddCommentOptions{Text: stringPtr("hi")})
+				return err
+			},
+			wantRequests: 1,
+		},
+		{
+			name:   "POST 429",
+			status: http.StatusTooManyRequests,
+			send: func(client *TrackerClient) error {
+				_, _, err := client.AddComment("TEST-1", &AddCommentOptions{Text: stringPtr("hi")})
+				return err
+			},
+			wantRequests: 3,
+		},
+		{
+			name:   "POST 5xx with unique value",
+			status: http.StatusBadGateway,
+			send: func(client *TrackerClient) error {
+				unique := "ext-1"
+				_, _, err := client.CreateIssue(&CreateIssueOptions{Queue: "TEST", Summary: stringPtr("s"), Unique: &unique})
+				return err
+			},
+			wantRequests: 3,
+		},
+		{
+			name:          "POST 5xx with non-idempotent retries",
+			status:        http.StatusBadGateway,
+			nonIdempotent: true,
+			send: func(client *TrackerClient) error {
+				_, _, err := client.AddComment("TEST-1", &AddCommentOptions{Text: stringPtr("hi")})
+				return err
+			},
+			wantRequests: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				w.WriteHeader(tt.status)
+			})
+			client.WithRetry(2, time.Millisecond, time.Millisecond)
+			client.WithRetryNonIdempotent(tt.nonIdempotent)
+
+			if err := tt.send(client); err == nil {
+				t.Error("expected an error")
+			}
+			if requests != tt.wantRequests {
+				t.Errorf("requests = %d, want %d", requests, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestRetryConditionSkipsTooLargeBody(t *testing.T) {
+	requests := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = w.Write([]byte(`{"key":"TEST-1","summary":"a summary longer than the limit"}`))
+	})
+	client.WithRetry(2, time.Millisecond, time.Millisecond)
+	client.WithMaxResponseBytes(16)
+
+	_, _, err := client.GetIssue("TEST-1")
+	if !errors.Is(err, ErrResponseTooLarge) {
+		t.Errorf("err = %v, want ErrResponseTooLarge", err)
+	}
+	if requests != 1 {
+		t.Errorf("requests = %d, want 1", requests)
+	}
+}
@@ -0,0 +1,138 @@
+package tracker
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Values of FieldSchema.Type decoded by DecodeField.
+const (
+	SchemaTypeString   = "string"
+	SchemaTypeInteger  = "integer"
+	SchemaTypeFloat    = "float"
+	SchemaTypeDate     = "date"
+	SchemaTypeDateTime = "datetime"
+	SchemaTypeUser     = "user"
+	SchemaTypeArray    = "array"
+)
+
+// LoadFieldSchema
+// Get the value schema of the global fields and of the local fields of the queues, keyed by field ID
+// as it appears in the issue JSON. Fields are fetched once and cached until RefreshFieldSchema;
+// queues not loaded before are fetched and added to the cache.
+func (t *TrackerClient) LoadFieldSchema(queueKeys ...string) (map[string]*FieldSchema, error) {
+	t.fieldSchemaMu.Lock()
+	defer t.fieldSchemaMu.Unlock()
+
+	if t.fieldSchema == nil {
+		fields, _, err := t.GetFields()
+		if err != nil {
+			return nil, err
+		}
+		t.fieldSchema = make(map[string]*FieldSchema, len(fields))
+		t.fieldSchemaQueues = make(map[string]bool)
+		addFieldSchema(t.fieldSchema, fields)
+	}
+	for _, queueKey := range queueKeys {
+		queueKey = t.CanonicalQueueKey(queueKey)
+		if t.fieldSchemaQueues[queueKey] {
+			continue
+		}
+		fields, _, err := t.GetQueueFields(queueKey)
+		if err != nil {
+			return nil, fmt.Errorf("queue %s fields: %w", queueKey, err)
+		}
+		addFieldSchema(t.fieldSchema, fields)
+		t.fieldSchemaQueues[queueKey] = true
+	}
+
+	result := make(map[string]*FieldSchema, len(t.fieldSchema))
+	for id, schema := range t.fieldSchema {
+		result[id] = schema
+	}
+
+	return result, nil
+}
+
+// RefreshFieldSchema
+// Drop the cached field schema, so the next LoadFieldSchema or DecodeField fetches it again
+func (t *TrackerClient) RefreshFieldSchema() {
+	t.fieldSchemaMu.Lock()
+	defer t.fieldSchemaMu.Unlock()
+
+	t.fieldSchema, t.fieldSchemaQueues = nil, nil
+}
+
+func addFieldSchema(schema map[string]*FieldSchema, fields []*Field) {
+	for _, field := range fields {
+		if field.Schema != nil {
+			schema[field.ID] = field.Schema
+		}
+	}
+}
+
+// DecodeField
+// Decode an issue field into a value of the type given by its schema: string, float64, time.Time, *BasicUser,
+// []string for arrays of strings or []*BasicUser for arrays of users. Numbers are json.Number instead of float64
+// if the client is set up with WithUseNumber. Fields of other or unknown types
+// are returned as json.RawMessage. Returns nil if the issue has no such field or it is null.
+// The schema of the issue queue is loaded with LoadFieldSchema if needed.
+func (t *TrackerClient) DecodeField(issue *Issue, fieldKey string) (interface{}, error) {
+	raw, ok := issue.Fields[fieldKey]
+	if !ok || string(raw) == "null" {
+		return nil, nil
+	}
+
+	var queueKeys []string
+	if issue.Queue != nil && issue.Queue.Key != "" {
+		queueKeys = append(queueKeys, issue.Queue.Key)
+	}
+	schemas, err := t.LoadFieldSchema(queueKeys...)
+	if err != nil {
+		return nil, err
+	}
+	schema := schemas[fieldKey]
+	if schema == nil {
+		return raw, nil
+	}
+
+	var value interface{}
+	switch {
+	case schema.Type == SchemaTypeString:
+		value = new(string)
+	case (schema.Type == SchemaTypeInteger || schema.Type == SchemaTypeFloat) && t.useNumber:
+		value = new(json.Number)
+	case schema.Type == SchemaTypeInteger || schema.Type == SchemaTypeFloat:
+		value = new(float64)
+	case schema.Type == SchemaTypeDate || schema.Type == SchemaTypeDateTime:
+		value = new(TrackerTime)
+	case schema.Type == SchemaTypeUser:
+		value = new(BasicUser)
+	case schema.Type == SchemaTypeArray && schema.Items == SchemaTypeString:
+		value = new(StringList)
+	case schema.Type == SchemaTypeArray && schema.Items == SchemaTypeUser:
+		value = new(UserList)
+	default:
+		return raw, nil
+	}
+	if err := json.Unmarshal(raw, value); err != nil {
+		return nil, fmt.Errorf("field %s of type %s: %w", fieldKey, schema.Type, err)
+	}
+
+	switch decoded := value.(type) {
+	case *string:
+		return *decoded, nil
+	case *float64:
+		return *decoded, nil
+	case *json.Number:
+		return *decoded, nil
+	case *TrackerTime:
+		return decoded.Time, nil
+	case *StringList:
+		return []string(*decoded), nil
+	case *UserList:
+		return []*BasicUser(*decoded), nil
+	default:
+		return decoded, nil
+	}
+}
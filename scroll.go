@@ -0,0 +1,112 @@
+package tracker
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Values of ScrollOptions.Type.
+const (
+	ScrollTypeSorted   = "sorted"
+	ScrollTypeUnsorted = "unsorted"
+)
+
+const (
+	// Largest number of issues per scroll page accepted by the API.
+	scrollMaxPerScroll = 1000
+
+	// Number of issues per scroll page when PerScroll is not set.
+	scrollDefaultPerScroll = 100
+)
+
+// ScrollOptions tune scrolling through search results, which unlike pages is not limited to 10000 issues.
+// For large exports PerScroll of 1000 with the unsorted type is the fastest; use the sorted type
+// when FindIssuesOptions.Order must be kept.
+// https://cloud.yandex.ru/en/docs/tracker/concepts/issues/search-issues#scroll
+type ScrollOptions struct {
+	// Scroll type, sorted or unsorted. The default value is sorted.
+	Type string
+
+	// Number of issues per scroll page, at most 1000. Larger values are lowered to the maximum with a warning.
+	// The default value is 100.
+	PerScroll int
+
+	// How long the API keeps the scroll context between pages. The default value is 5 seconds.
+	TTL time.Duration
+}
+
+func (t *TrackerClient) applyScroll(o *ScrollOptions, req *resty.Request) {
+	scrollType, perScroll := ScrollTypeSorted, scrollDefaultPerScroll
+	var ttl time.Duration
+	if o != nil {
+		if o.Type != "" {
+			scrollType = o.Type
+		}
+		if o.PerScroll > 0 {
+			perScroll = o.PerScroll
+		}
+		ttl = o.TTL
+	}
+	if perScroll > scrollMaxPerScroll {
+		t.logger.Warnf("perScroll %d exceeds the maximum, using %d", perScroll, scrollMaxPerScroll)
+		perScroll = scrollMaxPerScroll
+	}
+
+	req.SetQueryParam("scrollType", scrollType)
+	req.SetQueryParam("perScroll", strconv.Itoa(perScroll))
+	if ttl > 0 {
+		req.SetQueryParam("scrollTTLMillis", strconv.FormatInt(ttl.Milliseconds(), 10))
+	}
+}
+
+// scrollSearch
+// Run the search page by page, passing every request to fetch, which returns the number of issues it got.
+// Scrolling stops on an empty page or when the API returns no scroll ID.
+func (t *TrackerClient) scrollSearch(
+	opts *FindIssuesOptions, scrollOpts *ScrollOptions, fetch func(req *resty.Request) (int, *resty.Response, error),
+) error {
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+
+	var scrollID, scrollToken string
+	for {
+		req := t.NewRequest(resty.MethodPost, "/v2/issues/_search", opts)
+		if scrollID == "" {
+			t.applyScroll(scrollOpts, req)
+		} else {
+			req.SetQueryParam("scrollId", scrollID)
+			req.SetQueryParam("scrollToken", scrollToken)
+		}
+		got, resp, err := fetch(req)
+		if err != nil {
+			return err
+		}
+		scrollID, scrollToken = resp.Header().Get("X-Scroll-Id"), resp.Header().Get("X-Scroll-Token")
+		if got == 0 || scrollID == "" {
+			return nil
+		}
+	}
+}
+
+// FindIssuesScroll
+// Scroll through all issues matching the search, passing every scroll page to fn.
+// An error returned by fn stops scrolling and is returned as is.
+func (t *TrackerClient) FindIssuesScroll(
+	opts *FindIssuesOptions, scrollOpts *ScrollOptions, fn func(issues []*Issue) error,
+) error {
+	return t.scrollSearch(opts, scrollOpts, func(req *resty.Request) (int, *resty.Response, error) {
+		var issues []*Issue
+		resp, err := t.Do(req, &issues)
+		if err != nil {
+			return 0, nil, fmt.Errorf("request: %w", err)
+		}
+		if len(issues) == 0 {
+			return 0, resp, nil
+		}
+		return len(issues), resp, fn(issues)
+	})
+}
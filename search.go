@@ -0,0 +1,98 @@
+package tracker
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const (
+	// Date and time format of the query language. Values are compared in UTC.
+	queryTimeLayout = "2006-01-02 15:04:05"
+
+	// Date format of the query language for date fields such as Deadline.
+	queryDateLayout = "2006-01-02"
+
+	// Number of issues requested per page by FindAllIssues.
+	searchPerPage = 100
+)
+
+// FindAllIssues
+// Get all issues matching the search following pagination
+func (t *TrackerClient) FindAllIssues(opts *FindIssuesOptions) ([]*Issue, error) {
+	listOpts := &ListOptions{PerPage: searchPerPage, Page: 1}
+	var result []*Issue
+	for {
+		issues, resp, err := t.FindIssues(opts, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, issues...)
+		if !hasNextPage(resp, listOpts.Page, listOpts.PerPage, len(issues)) {
+			return result, nil
+		}
+		listOpts.Page++
+	}
+}
+
+// FindUpdatedSince
+// Get a page of queue issues updated at or after since, oldest update first
+func (t *TrackerClient) FindUpdatedSince(
+	queueKey string, since time.Time, listOpts *ListOptions,
+) ([]*Issue, *resty.Response, error) {
+	query := fmt.Sprintf(
+		`Queue: %s AND Updated: >= %s "Sort by": Updated ASC`,
+		quoteQueryValue(queueKey), quoteQueryTime(since),
+	)
+
+	return t.FindIssues(&FindIssuesOptions{Query: &query}, listOpts)
+}
+
+// FindDueSoon
+// Get a page of queue issues with the deadline between today and within from now, nearest deadline first.
+// Deadlines are dates, so the range is compared by day in UTC.
+func (t *TrackerClient) FindDueSoon(
+	queueKey string, within time.Duration, listOpts *ListOptions,
+) ([]*Issue, *resty.Response, error) {
+	now := time.Now().UTC()
+	query := fmt.Sprintf(
+		`Queue: %s AND Deadline: %s..%s "Sort by": Deadline ASC`,
+		quoteQueryValue(queueKey),
+		quoteQueryValue(now.Format(queryDateLayout)), quoteQueryValue(now.Add(within).Format(queryDateLayout)),
+	)
+
+	return t.FindIssues(&FindIssuesOptions{Query: &query}, listOpts)
+}
+
+// FindIssuesUpdatedBy
+// Get a page of issues last updated by the user at or after since, oldest update first
+func (t *TrackerClient) FindIssuesUpdatedBy(
+	login string, since time.Time, listOpts *ListOptions,
+) ([]*Issue, *resty.Response, error) {
+	query := fmt.Sprintf(
+		`"Updated By": %s AND Updated: >= %s "Sort by": Updated ASC`,
+		quoteQueryValue(login), quoteQueryTime(since),
+	)
+
+	return t.FindIssues(&FindIssuesOptions{Query: &query}, listOpts)
+}
+
+// GetEpicIssues
+// Get a page of issues linked to the epic or having it as the parent issue
+func (t *TrackerClient) GetEpicIssues(epicKey string, listOpts *ListOptions) ([]*Issue, *resty.Response, error) {
+	query := fmt.Sprintf(`Epic: %[1]s OR Parent: %[1]s`, quoteQueryValue(epicKey))
+
+	return t.FindIssues(&FindIssuesOptions{Query: &query}, listOpts)
+}
+
+// quoteQueryValue
+// Quote a value for the query language, escaping quotes and backslashes
+func quoteQueryValue(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
+
+func quoteQueryTime(t time.Time) string {
+	return quoteQueryValue(t.UTC().Format(queryTimeLayout))
+}
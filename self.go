@@ -0,0 +1,44 @@
+package tracker
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// SelfURL
+// Get the address of the API resource with information about the issue
+func (i *Issue) SelfURL() string {
+	return i.Self
+}
+
+// SelfURL
+// Get the address of the API resource with information about the comment
+func (c *Comment) SelfURL() string {
+	return c.Self
+}
+
+// SelfURL
+// Get the address of the API resource with information about the attachment
+func (a *Attachment) SelfURL() string {
+	return a.Self
+}
+
+// GetBySelf
+// Fetch the resource a "self" link of any payload points to and unmarshal it into v.
+// Links outside the Tracker API are rejected so that the token is never sent elsewhere.
+func (t *TrackerClient) GetBySelf(selfURL string, v interface{}) (*resty.Response, error) {
+	path, ok := strings.CutPrefix(selfURL, baseUrl)
+	if !ok || !strings.HasPrefix(path, "/") {
+		return nil, fmt.Errorf("self link %q is not a Tracker API address", selfURL)
+	}
+
+	req := t.NewRequest(resty.MethodGet, path, nil)
+	resp, err := t.Do(req, v)
+	if err != nil {
+		return nil, fmt.Errorf("request: %w", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,97 @@
+package tracker
+
+import "time"
+
+// Values of SLA.ClockStatus.
+const (
+	SLAClockStarted = "STARTED"
+	SLAClockPaused  = "PAUSED"
+	SLAClockStopped = "STOPPED"
+)
+
+// Values of SLA.ViolationStatus.
+const (
+	SLANotViolated  = "NOT_VIOLATED"
+	SLAWarnViolated = "WARN_VIOLATED"
+	SLAFailViolated = "FAIL_VIOLATED"
+)
+
+// SLA timer of an issue, one per SLA rule of the queue.
+type SLA struct {
+	// Timer ID.
+	ID int64 `json:"id"`
+
+	// ID of the SLA rule the timer belongs to.
+	SettingsID int64 `json:"settingsId"`
+
+	// Timer state, one of SLAClockStarted, SLAClockPaused or SLAClockStopped.
+	ClockStatus string `json:"clockStatus"`
+
+	// Whether the deadlines are breached, one of SLANotViolated, SLAWarnViolated or SLAFailViolated.
+	ViolationStatus string `json:"violationStatus"`
+
+	// Date and time when the warning deadline is reached.
+	WarnAt TrackerTime `json:"warnAt"`
+
+	// Date and time when the SLA is breached.
+	FailAt TrackerTime `json:"failAt"`
+
+	// Date and time when the timer was started.
+	StartedAt TrackerTime `json:"startedAt"`
+
+	// Date and time when the timer was paused.
+	PausedAt TrackerTime `json:"pausedAt"`
+
+	// Time the timer spent paused, in milliseconds.
+	PausedDuration int64 `json:"pausedDuration"`
+
+	// Time to the warning deadline, in minutes.
+	WarnThreshold int64 `json:"warnThreshold"`
+
+	// Time to the breach, in minutes.
+	FailThreshold int64 `json:"failThreshold"`
+}
+
+// Violated
+// Report whether the SLA is breached
+func (s *SLA) Violated() bool {
+	return s.ViolationStatus == SLAFailViolated
+}
+
+// TimeToSLABreach
+// Get the time left until the earliest breach among the running SLA timers of the issue, negative if it has passed,
+// and report whether any timer of the issue is already breached. Returns 0 if no timer is running.
+// The issue must have the sla field, e.g. from GetIssue.
+func TimeToSLABreach(issue *Issue) (time.Duration, bool) {
+	var (
+		earliest time.Time
+		violated bool
+	)
+	for _, sla := range issue.SLA {
+		if sla.Violated() {
+			violated = true
+		}
+		if sla.ClockStatus != SLAClockStarted || sla.FailAt.IsZero() {
+			continue
+		}
+		if earliest.IsZero() || sla.FailAt.Before(earliest) {
+			earliest = sla.FailAt.Time
+		}
+	}
+	if earliest.IsZero() {
+		return 0, violated
+	}
+
+	return time.Until(earliest), violated
+}
+
+// GetIssueSLA
+// Get the SLA timers of the issue. Tracker has no separate endpoint for them, so only the sla field is requested.
+func (t *TrackerClient) GetIssueSLA(issueKey string) ([]*SLA, error) {
+	issue, _, err := t.GetIssueFields(issueKey, []string{"sla"})
+	if err != nil {
+		return nil, err
+	}
+
+	return issue.SLA, nil
+}
@@ -1,5 +1,11 @@
 package tracker
 
+import (
+	"fmt"
+
+	"github.com/go-resty/resty/v2"
+)
+
 type BasicSprint struct {
 	// Address of the API resource with information about the sprint.
 	Self string `json:"self"`
@@ -10,3 +16,77 @@ type BasicSprint struct {
 	// Sprint name displayed.
 	Display string `json:"display"`
 }
+
+// Sprint structure in Yandex.Tracker
+// https://cloud.yandex.ru/en/docs/tracker/concepts/board/get-sprint
+type Sprint struct {
+	// Address of the API resource with information about the sprint.
+	Self string `json:"self"`
+
+	// Sprint ID.
+	ID int64 `json:"id"`
+
+	// Sprint version.
+	Version int64 `json:"version"`
+
+	// Sprint name.
+	Name string `json:"name"`
+
+	// Object with information about the board the sprint belongs to.
+	Board *BasicBoard `json:"board"`
+
+	// Sprint status: draft, in_progress, released or archived.
+	Status string `json:"status"`
+
+	// Whether the sprint is archived.
+	Archived bool `json:"archived"`
+
+	// Object with information about the user who created the sprint.
+	CreatedBy *BasicUser `json:"createdBy"`
+
+	// Date and time when the sprint was created.
+	CreatedAt TrackerTime `json:"createdAt"`
+
+	// Sprint start date in the YYYY-MM-DD format.
+	StartDate string `json:"startDate"`
+
+	// Sprint end date in the YYYY-MM-DD format.
+	EndDate string `json:"endDate"`
+
+	// Date and time when the sprint was started.
+	StartDateTime TrackerTime `json:"startDateTime"`
+
+	// Date and time when the sprint was finished.
+	EndDateTime TrackerTime `json:"endDateTime"`
+}
+
+func (t *TrackerClient) GetSprint(sprintID string) (*Sprint, *resty.Response, error) {
+	req := t.NewRequest(resty.MethodGet, "/v2/sprints/"+sprintID, nil)
+	result := new(Sprint)
+	resp, err := t.Do(req, result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+// GetIssueSprints
+// Get the sprints the issue is planned in, with their board and status.
+func (t *TrackerClient) GetIssueSprints(issueKey string) ([]*Sprint, error) {
+	issue, _, err := t.GetIssueFields(issueKey, []string{"sprint"})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*Sprint, 0, len(issue.Sprint))
+	for _, ref := range issue.Sprint {
+		sprint, _, err := t.GetSprint(ref.ID)
+		if err != nil {
+			return nil, fmt.Errorf("sprint %s: %w", ref.ID, err)
+		}
+		result = append(result, sprint)
+	}
+
+	return result, nil
+}
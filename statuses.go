@@ -15,3 +15,45 @@ type BasicStatus struct {
 	// Status name displayed.
 	Display string `json:"display"`
 }
+
+// Keys of status types. A status type groups statuses that differ between queues.
+const (
+	StatusTypeNew        = "new"
+	StatusTypeInProgress = "inProgress"
+	StatusTypePaused     = "paused"
+	StatusTypeDone       = "done"
+	StatusTypeCancelled  = "cancelled"
+)
+
+// StatusType
+// https://cloud.yandex.ru/en/docs/tracker/concepts/issues/get-issue#statustype
+type StatusType struct {
+	// Status type ID.
+	ID string `json:"id"`
+
+	// Status type key, one of the StatusType constants.
+	Key string `json:"key"`
+
+	// Status type name displayed.
+	Display string `json:"display"`
+}
+
+// IsDone
+// Report whether the issue status is of a final type: done or cancelled
+func IsDone(issue *Issue) bool {
+	if issue == nil || issue.StatusType == nil {
+		return false
+	}
+
+	return issue.StatusType.Key == StatusTypeDone || issue.StatusType.Key == StatusTypeCancelled
+}
+
+// IsOpen
+// Report whether the issue status is of a type where work is still expected: new, in progress or paused
+func IsOpen(issue *Issue) bool {
+	if issue == nil || issue.StatusType == nil {
+		return false
+	}
+
+	return !IsDone(issue)
+}
@@ -0,0 +1,94 @@
+package tracker
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Number of bytes read from what is left of a streaming body before closing it.
+const drainLimit = 64 << 10
+
+// DoStream
+// Send the request without buffering the response and decode the JSON array
+// in the body element by element, calling fn with a decoder positioned at each element.
+// The body is closed before DoStream returns, also when fn stops early with an error, so streaming
+// and buffered requests can share the client without holding connections.
+func (t *TrackerClient) DoStream(req *resty.Request, fn func(dec *json.Decoder) error) (*resty.Response, error) {
+	if err := t.limiter.wait(req.Context()); err != nil {
+		return nil, fmt.Errorf("rate limit: %w", err)
+	}
+	resp, err := req.SetDoNotParseResponse(true).Send()
+	if err != nil {
+		return nil, fmt.Errorf("request: %w", err)
+	}
+	body := resp.RawBody()
+	defer closeBody(body)
+
+	if resp.IsError() {
+		message, _ := io.ReadAll(io.LimitReader(body, drainLimit))
+		return nil, t.newAPIError(resp.StatusCode(), resp.Header(), message)
+	}
+
+	dec := json.NewDecoder(body)
+	if t.useNumber {
+		dec.UseNumber()
+	}
+	if err := expectDelim(dec, '['); err != nil {
+		return nil, err
+	}
+	for dec.More() {
+		if err := fn(dec); err != nil {
+			return nil, err
+		}
+	}
+	if err := expectDelim(dec, ']'); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// FindIssuesStream
+// Search Yandex.Tracker issues like FindIssues, decoding the response incrementally
+// and calling fn for every issue instead of collecting them into a slice.
+func (t *TrackerClient) FindIssuesStream(
+	opts *FindIssuesOptions, listOpts *ListOptions, fn func(issue *Issue) error,
+) (*resty.Response, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	req := t.NewRequest(resty.MethodPost, "/v2/issues/_search", opts)
+	listOpts.apply(req)
+
+	return t.DoStream(req, func(dec *json.Decoder) error {
+		issue := new(Issue)
+		if err := dec.Decode(issue); err != nil {
+			return fmt.Errorf("json.Decode: %w", err)
+		}
+		return fn(issue)
+	})
+}
+
+// closeBody
+// Drain the rest of a streaming body, at most drainLimit bytes, and close it.
+// A fully read body lets the transport reuse the connection; a larger rest is dropped with the connection
+func closeBody(body io.ReadCloser) {
+	_, _ = io.Copy(io.Discard, io.LimitReader(body, drainLimit))
+	_ = body.Close()
+}
+
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	token, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("json.Token: %w", err)
+	}
+	if token != delim {
+		return fmt.Errorf("json.Token: expected %s, got %v", delim, token)
+	}
+
+	return nil
+}
@@ -1,5 +1,7 @@
 package tracker
 
+import "encoding/json"
+
 type Ticket map[string]interface{}
 
 // CreatedBy
@@ -96,6 +98,8 @@ func toString(v interface{}) string {
 	switch v.(type) {
 	case string:
 		return v.(string)
+	case json.Number:
+		return v.(json.Number).String()
 	default:
 		return ""
 	}
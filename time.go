@@ -0,0 +1,53 @@
+package tracker
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Timestamp format used by Yandex.Tracker, e.g. 2017-06-11T05:16:01.339+0000.
+const timeLayout = "2006-01-02T15:04:05.000-0700"
+
+// Layouts accepted when decoding Tracker timestamps, most common first.
+var timeLayouts = []string{
+	timeLayout,
+	"2006-01-02T15:04:05-0700",
+	time.RFC3339Nano,
+	"2006-01-02",
+}
+
+// TrackerTime is a Yandex.Tracker timestamp.
+// Tracker writes offsets without a colon, which time.Time cannot decode by default.
+// An empty or null value decodes into the zero time.
+type TrackerTime struct {
+	time.Time
+}
+
+func (t TrackerTime) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(t.Format(timeLayout))
+}
+
+func (t *TrackerTime) UnmarshalJSON(data []byte) error {
+	var s *string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == nil || *s == "" {
+		t.Time = time.Time{}
+		return nil
+	}
+
+	for _, layout := range timeLayouts {
+		if parsed, err := time.Parse(layout, *s); err == nil {
+			t.Time = parsed
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid time %q", *s)
+}
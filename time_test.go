@@ -0,0 +1,85 @@
+package tracker
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestTrackerTimeUnmarshal(t *testing.T) {
+	msk := time.FixedZone("", 3*60*60)
+	tests := []struct {
+		data string
+		want time.Time
+	}{
+		{data: `"2017-06-11T05:16:01.339+0000"`, want: time.Date(2017, 6, 11, 5, 16, 1, 339000000, time.UTC)},
+		{data: `"2023-01-02T15:04:05.000+0300"`, want: time.Date(2023, 1, 2, 15, 4, 5, 0, msk)},
+		{data: `"2023-01-02T15:04:05+0300"`, want: time.Date(2023, 1, 2, 15, 4, 5, 0, msk)},
+		{data: `"2023-01-02T15:04:05.5+03:00"`, want: time.Date(2023, 1, 2, 15, 4, 5, 500000000, msk)},
+		{data: `"2023-01-02"`, want: time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{data: `""`},
+		{data: `null`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.data, func(t *testing.T) {
+			var got TrackerTime
+			if err := json.Unmarshal([]byte(tt.data), &got); err != nil {
+				t.Fatalf("Unmarshal: %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("got %v, want %v", got.Time, tt.want)
+			}
+		})
+	}
+
+	var invalid TrackerTime
+	if err := json.Unmarshal([]byte(`"yesterday"`), &invalid); err == nil {
+		t.Error("invalid time decoded without an error")
+	}
+}
+
+func TestTrackerTimeMarshal(t *testing.T) {
+	data, err := json.Marshal(struct {
+		Set   TrackerTime `json:"set"`
+		Unset TrackerTime `json:"unset"`
+	}{Set: TrackerTime{time.Date(2017, 6, 11, 5, 16, 1, 339000000, time.UTC)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"set":"2017-06-11T05:16:01.339+0000","unset":null}`; string(data) != want {
+		t.Errorf("Marshal = %s, want %s", data, want)
+	}
+}
+
+func TestTrackerTimeInResponses(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /v2/issues/TEST-1":
+			_, _ = w.Write([]byte(`{"key":"TEST-1","createdAt":"2017-06-11T05:16:01.339+0000",
+				"updatedAt":"2017-07-18T13:33:44.291+0000","resolvedAt":null}`))
+		case "GET /v2/issues/TEST-1/comments/9":
+			_, _ = w.Write([]byte(`{"id":9,"createdAt":"2017-06-11T05:16:01.339+0000","updatedAt":""}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	issue, _, err := client.GetIssue("TEST-1")
+	if err != nil {
+		t.Fatalf("GetIssue: %v", err)
+	}
+	if !issue.CreatedAt.Equal(time.Date(2017, 6, 11, 5, 16, 1, 339000000, time.UTC)) ||
+		!issue.UpdatedAt.Equal(time.Date(2017, 7, 18, 13, 33, 44, 291000000, time.UTC)) || !issue.ResolvedAt.IsZero() {
+		t.Errorf("issue times = %v, %v, %v", issue.CreatedAt, issue.UpdatedAt, issue.ResolvedAt)
+	}
+
+	comment, _, err := client.GetComment("TEST-1", "9", "")
+	if err != nil {
+		t.Fatalf("GetComment: %v", err)
+	}
+	if comment.CreatedAt.Year() != 2017 || !comment.UpdatedAt.IsZero() {
+		t.Errorf("comment times = %v, %v", comment.CreatedAt, comment.UpdatedAt)
+	}
+}
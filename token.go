@@ -0,0 +1,119 @@
+package tracker
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// TokenProvider supplies the Authorization header value, e.g. a Yandex Cloud IAM token that expires.
+// Token is called before every request, so it should cache the token until it is about to expire.
+type TokenProvider interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// TokenInvalidator may be implemented by a TokenProvider to drop a cached token rejected with 401,
+// so that the retried request gets a fresh one.
+type TokenInvalidator interface {
+	InvalidateToken(token string)
+}
+
+// OAuthAuthorization
+// Build the Authorization header value for an OAuth token
+func OAuthAuthorization(token string) string {
+	return "OAuth " + token
+}
+
+// BearerAuthorization
+// Build the Authorization header value for an IAM token
+func BearerAuthorization(token string) string {
+	return "Bearer " + token
+}
+
+// WithTokenProvider
+// Get the Authorization header from p before every request instead of the token passed to New.
+// Requests sent with Do that fail with 401 are retried once with a fresh token
+func (t *TrackerClient) WithTokenProvider(p TokenProvider) {
+	if t.tokenProvider == nil {
+		t.client.OnBeforeRequest(func(_ *resty.Client, req *resty.Request) error {
+			if t.tokenProvider == nil {
+				return nil
+			}
+			token, err := t.tokenProvider.Token(req.Context())
+			if err != nil {
+				return fmt.Errorf("token: %w", err)
+			}
+			req.SetHeader("Authorization", token)
+			return nil
+		})
+	}
+	t.tokenProvider = p
+}
+
+// send
+// Send the request, retrying it once with a fresh token if the token from the provider was rejected.
+// The retry is a new request built with replayRequest, so requests whose body cannot be sent again are not retried
+func (t *TrackerClient) send(req *resty.Request) (*resty.Response, error) {
+	if t.tokenProvider == nil {
+		return req.Send()
+	}
+
+	rawURL := req.URL
+	resp, err := req.Send()
+	if err != nil || resp.StatusCode() != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	if invalidator, ok := t.tokenProvider.(TokenInvalidator); ok {
+		invalidator.InvalidateToken(req.Header.Get("Authorization"))
+	}
+	retry, ok := t.replayRequest(req, rawURL)
+	if !ok {
+		return resp, nil
+	}
+
+	return retry.Send()
+}
+
+// replayRequest
+// Build a copy of the sent request with its URL before resty added the query parameters to it.
+// A sent request cannot be sent again as is: resty would append the query parameters a second time.
+// Reports false if the body cannot be replayed, e.g. a multipart upload or a reader that cannot seek back to the start
+func (t *TrackerClient) replayRequest(req *resty.Request, rawURL string) (*resty.Request, bool) {
+	if strings.HasPrefix(req.Header.Get("Content-Type"), "multipart/") {
+		return nil, false
+	}
+	if reader, ok := req.Body.(io.Reader); ok {
+		seeker, ok := reader.(io.Seeker)
+		if !ok {
+			return nil, false
+		}
+		if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+			return nil, false
+		}
+	}
+
+	retry := t.client.R().SetContext(req.Context())
+	retry.Method = req.Method
+	retry.URL = rawURL
+	retry.Body = req.Body
+	retry.Header = req.Header.Clone()
+	retry.QueryParam = cloneValues(req.QueryParam)
+	retry.FormData = cloneValues(req.FormData)
+
+	return retry, true
+}
+
+func cloneValues(values url.Values) url.Values {
+	result := make(url.Values, len(values))
+	for key, value := range values {
+		result[key] = append([]string(nil), value...)
+	}
+
+	return result
+}
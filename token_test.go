@@ -0,0 +1,71 @@
+package tracker
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+type testTokenProvider struct {
+	mu     sync.Mutex
+	tokens []string
+}
+
+func (p *testTokenProvider) Token(context.Context) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.tokens[0], nil
+}
+
+func (p *testTokenProvider) InvalidateToken(string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.tokens) > 1 {
+		p.tokens = p.tokens[1:]
+	}
+}
+
+func TestSendRetriesWithFreshToken(t *testing.T) {
+	var queries []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		if r.Header.Get("Authorization") != "Bearer fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`[{"id":1,"text":"hi"}]`))
+	})
+	client.WithTokenProvider(&testTokenProvider{tokens: []string{"Bearer stale", "Bearer fresh"}})
+
+	comments, _, err := client.GetComments("TEST-1", &ListCommentsOptions{PerPage: 50})
+	if err != nil {
+		t.Fatalf("GetComments: %v", err)
+	}
+	if len(comments) != 1 || comments[0].Text != "hi" {
+		t.Errorf("comments = %+v", comments)
+	}
+	if len(queries) != 2 || queries[0] != "perPage=50" || queries[1] != "perPage=50" {
+		t.Errorf("queries = %q, want perPage=50 twice", queries)
+	}
+}
+
+func TestSendDoesNotReplayMultipart(t *testing.T) {
+	requests := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+	client.WithTokenProvider(&testTokenProvider{tokens: []string{"Bearer stale", "Bearer fresh"}})
+
+	_, _, err := client.AttachFile("TEST-1", "a.txt", strings.NewReader("content"))
+	if !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("err = %v, want ErrUnauthorized", err)
+	}
+	if requests != 1 {
+		t.Errorf("requests = %d, want 1", requests)
+	}
+}
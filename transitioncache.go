@@ -0,0 +1,102 @@
+package tracker
+
+import (
+	"sync"
+	"time"
+)
+
+// transitionCache keeps transition lists by queue, issue type and status for a limited time.
+type transitionCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]transitionCacheEntry
+}
+
+type transitionCacheEntry struct {
+	transitions []*Transition
+	expires     time.Time
+}
+
+func newTransitionCache(ttl time.Duration) *transitionCache {
+	return &transitionCache{ttl: ttl, entries: make(map[string]transitionCacheEntry)}
+}
+
+func (c *transitionCache) get(key string) ([]*Transition, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+
+	return entry.transitions, true
+}
+
+func (c *transitionCache) put(key string, transitions []*Transition) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = transitionCacheEntry{transitions: transitions, expires: time.Now().Add(c.ttl)}
+}
+
+// transitionCacheKey
+// Get the key of the workflow state the issue is in, empty if the issue lacks queue, type or status
+func transitionCacheKey(issue *Issue) string {
+	if issue.Queue == nil || issue.Type == nil || issue.Status == nil {
+		return ""
+	}
+
+	return issue.Queue.Key + "/" + issue.Type.Key + "/" + issue.Status.Key
+}
+
+// WithTransitionCache
+// Cache the transitions got with GetIssueTransitions by queue, issue type and status for ttl; 0 disables the cache.
+// Transitions with conditions on other fields, e.g. the assignee, may differ between issues in the same status,
+// so only enable the cache for workflows without such conditions.
+func (t *TrackerClient) WithTransitionCache(ttl time.Duration) {
+	if ttl <= 0 {
+		t.transitions = nil
+		return
+	}
+	t.transitions = newTransitionCache(ttl)
+}
+
+// GetIssueTransitions
+// Get the transitions available for the issue, reusing the ones cached for its queue, type and status
+func (t *TrackerClient) GetIssueTransitions(issue *Issue) ([]*Transition, error) {
+	key := transitionCacheKey(issue)
+	if key != "" {
+		if transitions, ok := t.transitions.get(key); ok {
+			return transitions, nil
+		}
+	}
+
+	transitions, _, err := t.GetTransitions(issue.Key)
+	if err != nil {
+		return nil, err
+	}
+	if key != "" {
+		t.transitions.put(key, transitions)
+	}
+
+	return transitions, nil
+}
+
+// FindIssueTransitionTo
+// Get the transition leading to the status with targetStatusKey as FindTransitionTo does, using GetIssueTransitions
+func (t *TrackerClient) FindIssueTransitionTo(issue *Issue, targetStatusKey string) (*Transition, error) {
+	transitions, err := t.GetIssueTransitions(issue)
+	if err != nil {
+		return nil, err
+	}
+
+	return transitionTo(transitions, targetStatusKey)
+}
@@ -0,0 +1,346 @@
+package tracker
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Transition structure in Yandex.Tracker
+// https://cloud.yandex.ru/en/docs/tracker/concepts/issues/get-transitions
+type Transition struct {
+	// Address of the API resource with information about the transition.
+	Self string `json:"self"`
+
+	// Transition ID.
+	ID string `json:"id"`
+
+	// Transition name displayed.
+	Display string `json:"display"`
+
+	// Object with information about the target status.
+	To *BasicStatus `json:"to"`
+
+	// Object with information about the transition screen.
+	// Only set for transitions that ask for field values before they are executed.
+	Screen *BasicScreen `json:"screen"`
+}
+
+type BasicScreen struct {
+	// Address of the API resource with information about the screen.
+	Self string `json:"self"`
+
+	// Screen ID.
+	ID string `json:"id"`
+}
+
+// TransitionScreen lists the fields asked for when executing a transition.
+type TransitionScreen struct {
+	// Address of the API resource with information about the screen.
+	Self string `json:"self"`
+
+	// Screen ID.
+	ID string `json:"id"`
+
+	// Fields shown on the screen.
+	Elements []*ScreenElement `json:"elements"`
+}
+
+type ScreenElement struct {
+	// Object with information about the field.
+	Field *FieldRef `json:"field"`
+
+	// Flag indicating that the field must be set to execute the transition.
+	Required bool `json:"required"`
+}
+
+type FieldRef struct {
+	// Address of the API resource with information about the field.
+	Self string `json:"self"`
+
+	// Field ID.
+	ID string `json:"id"`
+
+	// Field name displayed.
+	Display string `json:"display"`
+}
+
+func (t *TrackerClient) GetTransitions(issueKey string) ([]*Transition, *resty.Response, error) {
+	req := t.NewRequest(resty.MethodGet, "/v2/issues/"+issueKey+"/transitions", nil)
+	var result []*Transition
+	resp, err := t.Do(req, &result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+// FindTransitionTo
+// Get the issue transition leading to the status with targetStatusKey.
+// Returns an error wrapping ErrNotFound if no such transition is available.
+func (t *TrackerClient) FindTransitionTo(issueKey, targetStatusKey string) (*Transition, error) {
+	transitions, _, err := t.GetTransitions(issueKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return transitionTo(transitions, targetStatusKey)
+}
+
+// AllowedNextStatuses
+// Get the statuses the issue can be moved to with its available transitions, in the order of the transitions.
+// A status reachable by several transitions is listed once.
+func (t *TrackerClient) AllowedNextStatuses(issueKey string) ([]*BasicStatus, error) {
+	transitions, _, err := t.GetTransitions(issueKey)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(transitions))
+	var result []*BasicStatus
+	for _, transition := range transitions {
+		if transition.To == nil || seen[transition.To.Key] {
+			continue
+		}
+		seen[transition.To.Key] = true
+		result = append(result, transition.To)
+	}
+
+	return result, nil
+}
+
+func transitionTo(transitions []*Transition, targetStatusKey string) (*Transition, error) {
+	for _, transition := range transitions {
+		if transition.To != nil && transition.To.Key == targetStatusKey {
+			return transition, nil
+		}
+	}
+
+	return nil, fmt.Errorf("transition to status %s: %w", targetStatusKey, ErrNotFound)
+}
+
+// GetTransitionScreen
+// Get the fields to fill in to execute the transition. Returns nil if the transition has no screen.
+func (t *TrackerClient) GetTransitionScreen(issueKey, transitionID string) (*TransitionScreen, error) {
+	transitions, _, err := t.GetTransitions(issueKey)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, transition := range transitions {
+		if transition.ID != transitionID {
+			continue
+		}
+		if transition.Screen == nil {
+			return nil, nil
+		}
+
+		req := t.NewRequest(resty.MethodGet, "/v2/screens/"+transition.Screen.ID, nil)
+		result := new(TransitionScreen)
+		if _, err := t.Do(req, result); err != nil {
+			return nil, fmt.Errorf("request: %w", err)
+		}
+		return result, nil
+	}
+
+	return nil, fmt.Errorf("transition %s: %w", transitionID, ErrNotFound)
+}
+
+// CanTransition
+// Check before executing the transition that the issue has it and that opts set every field its screen requires.
+// Reports false without an error if the transition is not available, and returns a *MissingFieldsError
+// listing the required fields opts leave out.
+func (t *TrackerClient) CanTransition(issueKey, transitionID string, opts *ExecuteTransitionOptions) (bool, error) {
+	screen, err := t.GetTransitionScreen(issueKey, transitionID)
+	var apiErr *APIError
+	switch {
+	case errors.Is(err, ErrNotFound) && !errors.As(err, &apiErr):
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+
+	var missing []string
+	for _, field := range screen.RequiredFields() {
+		if !opts.sets(field) {
+			missing = append(missing, field)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return false, &MissingFieldsError{Fields: missing}
+	}
+
+	return true, nil
+}
+
+// sets
+// Report whether the options give a value to the field. A cleared field, e.g. with ClearResolution
+// or a nil value in Fields, is sent as null and does not count
+func (o *ExecuteTransitionOptions) sets(fieldID string) bool {
+	if o == nil {
+		return false
+	}
+	switch fieldID {
+	case "comment":
+		return o.Comment != nil || o.Fields[fieldID] != nil
+	case "resolution":
+		return (o.Resolution != nil || o.Fields[fieldID] != nil) && !o.ClearResolution
+	}
+
+	return o.Fields[fieldID] != nil
+}
+
+// RequiredFields
+// Get IDs of the fields that must be set on the screen
+func (s *TransitionScreen) RequiredFields() []string {
+	if s == nil {
+		return nil
+	}
+
+	var result []string
+	for _, element := range s.Elements {
+		if element.Required && element.Field != nil {
+			result = append(result, element.Field.ID)
+		}
+	}
+
+	return result
+}
+
+// https://cloud.yandex.ru/en/docs/tracker/concepts/issues/new-transition
+type ExecuteTransitionOptions struct {
+	// Comment added to the issue with the transition.
+	Comment *string
+
+	// Issue resolution key, e.g. fixed. Required by transitions to resolved statuses.
+	Resolution *string
+
+	// Reset the issue resolution, e.g. when reopening.
+	ClearResolution bool
+
+	// Other issue fields set with the transition, keyed by field ID.
+	Fields map[string]interface{}
+
+	// Notification settings passed as query parameters.
+	Notify *NotifyOptions
+}
+
+// MarshalJSON
+// Write the comment, resolution and fields as one flat object as the API expects
+func (o *ExecuteTransitionOptions) MarshalJSON() ([]byte, error) {
+	body := make(map[string]interface{}, len(o.Fields)+2)
+	for key, value := range o.Fields {
+		body[key] = value
+	}
+	if o.Comment != nil {
+		body["comment"] = *o.Comment
+	}
+	switch {
+	case o.ClearResolution:
+		body["resolution"] = nil
+	case o.Resolution != nil:
+		body["resolution"] = *o.Resolution
+	}
+
+	return json.Marshal(body)
+}
+
+// ExecuteTransition
+// Execute the issue transition. Returns the transitions available after it.
+func (t *TrackerClient) ExecuteTransition(
+	issueKey, transitionID string, opts *ExecuteTransitionOptions,
+) ([]*Transition, *resty.Response, error) {
+	if opts == nil {
+		opts = &ExecuteTransitionOptions{}
+	}
+	req := t.NewRequest(resty.MethodPost, "/v2/issues/"+issueKey+"/transitions/"+transitionID+"/_execute", opts)
+	opts.Notify.apply(req)
+	var result []*Transition
+	resp, err := t.Do(req, &result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+// ReopenIssue
+// Execute the transition that reopens a closed issue, resetting its resolution and adding the comment if it is not empty.
+// The reopen transition is the one with the reopen ID or, failing that, the one leading to the open status.
+// Returns an error wrapping ErrNotFound if the issue has no such transition.
+func (t *TrackerClient) ReopenIssue(issueKey, comment string) (*Issue, error) {
+	transitions, _, err := t.GetTransitions(issueKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var reopen *Transition
+	for _, transition := range transitions {
+		if transition.ID == "reopen" {
+			reopen = transition
+			break
+		}
+		if reopen == nil && transition.To != nil && transition.To.Key == "open" {
+			reopen = transition
+		}
+	}
+	if reopen == nil {
+		return nil, fmt.Errorf("reopen transition: %w", ErrNotFound)
+	}
+
+	opts := &ExecuteTransitionOptions{ClearResolution: true}
+	if comment != "" {
+		opts.Comment = &comment
+	}
+	if _, _, err := t.ExecuteTransition(issueKey, reopen.ID, opts); err != nil {
+		return nil, err
+	}
+	issue, _, err := t.GetIssue(issueKey)
+
+	return issue, err
+}
+
+// ResolveWithComment
+// Execute the transition that closes the issue, setting the resolution and adding the comment in the same request,
+// so the comment is not left behind if the transition fails.
+// The closing transition is the one with the close or resolve ID or, failing that, the one leading to the closed or
+// resolved status. Returns an error wrapping ErrNotFound if the issue has no such transition.
+func (t *TrackerClient) ResolveWithComment(issueKey, resolution, commentText string) (*Issue, error) {
+	if resolution == "" {
+		return nil, &ValidationError{Field: "resolution", Reason: "is required to resolve an issue"}
+	}
+	transitions, _, err := t.GetTransitions(issueKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var resolve *Transition
+	for _, transition := range transitions {
+		if transition.ID == "close" || transition.ID == "resolve" {
+			resolve = transition
+			break
+		}
+		if resolve == nil && transition.To != nil && (transition.To.Key == "closed" || transition.To.Key == "resolved") {
+			resolve = transition
+		}
+	}
+	if resolve == nil {
+		return nil, fmt.Errorf("resolve transition: %w", ErrNotFound)
+	}
+
+	opts := &ExecuteTransitionOptions{Resolution: &resolution}
+	if commentText != "" {
+		opts.Comment = &commentText
+	}
+	if _, _, err := t.ExecuteTransition(issueKey, resolve.ID, opts); err != nil {
+		return nil, err
+	}
+	issue, _, err := t.GetIssue(issueKey)
+
+	return issue, err
+}
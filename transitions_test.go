@@ -0,0 +1,90 @@
+package tracker
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestCanTransition(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /v2/issues/TEST-1/transitions":
+			_, _ = w.Write([]byte(`[
+				{"id":"start","to":{"key":"inProgress"}},
+				{"id":"close","to":{"key":"closed"},"screen":{"id":"s1"}}]`))
+		case "GET /v2/screens/s1":
+			_, _ = w.Write([]byte(`{"id":"s1","elements":[
+				{"field":{"id":"resolution"},"required":true},
+				{"field":{"id":"timeSpent"},"required":true},
+				{"field":{"id":"comment"},"required":true}]}`))
+		case "GET /v2/issues/MISSING-1/transitions":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	tests := []struct {
+		name        string
+		transition  string
+		opts        *ExecuteTransitionOptions
+		want        bool
+		wantMissing []string
+	}{
+		{name: "no screen", transition: "start", want: true},
+		{name: "unknown transition", transition: "reopen", want: false},
+		{
+			name:       "all required fields",
+			transition: "close",
+			opts: &ExecuteTransitionOptions{
+				Comment: stringPtr("done"), Resolution: stringPtr("fixed"), Fields: map[string]interface{}{"timeSpent": "PT1H"},
+			},
+			want: true,
+		},
+		{
+			name:       "required fields in Fields",
+			transition: "close",
+			opts: &ExecuteTransitionOptions{
+				Fields: map[string]interface{}{"comment": "done", "resolution": "fixed", "timeSpent": "PT1H"},
+			},
+			want: true,
+		},
+		{name: "no options", transition: "close", wantMissing: []string{"comment", "resolution", "timeSpent"}},
+		{
+			name:        "cleared fields",
+			transition:  "close",
+			opts:        &ExecuteTransitionOptions{Resolution: stringPtr("fixed"), ClearResolution: true, Fields: map[string]interface{}{"timeSpent": nil, "comment": nil}},
+			wantMissing: []string{"comment", "resolution", "timeSpent"},
+		},
+		{
+			name:       "cleared resolution in Fields",
+			transition: "close",
+			opts: &ExecuteTransitionOptions{
+				ClearResolution: true, Fields: map[string]interface{}{"comment": "done", "resolution": "fixed", "timeSpent": "PT1H"},
+			},
+			wantMissing: []string{"resolution"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, err := client.CanTransition("TEST-1", tt.transition, tt.opts)
+			if ok != tt.want {
+				t.Errorf("CanTransition = %v, want %v", ok, tt.want)
+			}
+			var missingErr *MissingFieldsError
+			switch {
+			case tt.wantMissing == nil && err != nil:
+				t.Errorf("err = %v", err)
+			case tt.wantMissing != nil && (!errors.As(err, &missingErr) || !reflect.DeepEqual(missingErr.Fields, tt.wantMissing)):
+				t.Errorf("err = %v, want missing %v", err, tt.wantMissing)
+			}
+		})
+	}
+
+	if _, err := client.CanTransition("MISSING-1", "close", nil); !errors.Is(err, ErrNotFound) {
+		t.Errorf("err = %v, want ErrNotFound for a missing issue", err)
+	}
+}
@@ -0,0 +1,46 @@
+package tracker
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Number of redirects followed by a new client.
+const defaultMaxRedirects = 3
+
+// WithTransportConfig
+// Tune connection reuse of the underlying http.Transport, e.g. for many parallel workers.
+// maxIdleConnsPerHost should be at least the number of concurrent requests to keep connections alive between them.
+func (t *TrackerClient) WithTransportConfig(maxIdleConns, maxIdleConnsPerHost int, idleTimeout time.Duration) {
+	var transport *http.Transport
+	if current, ok := t.baseTransport().(*http.Transport); ok {
+		transport = current.Clone()
+	} else {
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+	}
+
+	transport.MaxIdleConns = maxIdleConns
+	transport.MaxIdleConnsPerHost = maxIdleConnsPerHost
+	transport.IdleConnTimeout = idleTimeout
+	t.setTransport(transport)
+}
+
+// WithMaxResponseBytes
+// Fail requests whose response body is larger than n bytes with an error matching ErrResponseTooLarge; 0 means no limit.
+// Streaming helpers such as DoStream and DownloadAttachment do not buffer the body and are not limited.
+func (t *TrackerClient) WithMaxResponseBytes(n int64) {
+	t.client.SetResponseBodyLimit(int(n))
+}
+
+// WithRedirectPolicy
+// Replace the redirect policy, which follows up to 3 redirects by default.
+// Use resty.NoRedirectPolicy() to fail on any redirect or resty.FlexibleRedirectPolicy(n) to follow up to n.
+func (t *TrackerClient) WithRedirectPolicy(policies ...resty.RedirectPolicy) {
+	values := make([]interface{}, len(policies))
+	for i, policy := range policies {
+		values[i] = policy
+	}
+	t.client.SetRedirectPolicy(values...)
+}
@@ -1,11 +1,16 @@
 package tracker
 
 import (
-	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
+
+	"github.com/go-resty/resty/v2"
 )
 
+// Number of users requested per page by GetAllUsers.
+const usersPerPage = 100
+
 type BasicUsers []BasicUser
 
 // User
@@ -93,10 +98,10 @@ type User struct {
 	DisableNotifications bool `json:"disableNotifications"`
 
 	// Date and time of the user's first authentication, in the YYYY-MM-DDThh:mm:ss.sss±hhmm format
-	FirstLoginDate string `json:"firstLoginDate"`
+	FirstLoginDate TrackerTime `json:"firstLoginDate"`
 
 	// Date and time of the user's last authentication, in the YYYY-MM-DDThh:mm:ss.sss±hhmm format
-	LastLoginDate string `json:"lastLoginDate"`
+	LastLoginDate TrackerTime `json:"lastLoginDate"`
 
 	// Method of adding a user:
 	// true: By sending an invitation by email
@@ -112,15 +117,95 @@ func (t *TrackerClient) Myself() (*User, error) {
 	}
 
 	if resp.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf(
-			"wrong status code: %d, message=%s, headers=%s", resp.StatusCode(), string(resp.Body()), t.headers,
-		)
+		return nil, t.newAPIError(resp.StatusCode(), resp.Header(), resp.Body())
 	}
 
 	result := new(User)
-	if err := json.Unmarshal(resp.Body(), result); err != nil {
+	if err := t.unmarshal(resp.Body(), result); err != nil {
 		return nil, fmt.Errorf("json.Unmarshal: %w", err)
 	}
 
 	return result, nil
 }
+
+func (t *TrackerClient) GetUsers(listOpts *ListOptions) ([]*User, *resty.Response, error) {
+	req := t.NewRequest(resty.MethodGet, "/v2/users", nil)
+	listOpts.apply(req)
+	var result []*User
+	resp, err := t.Do(req, &result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+func (t *TrackerClient) GetAllUsers() ([]*User, error) {
+	listOpts := &ListOptions{PerPage: usersPerPage, Page: 1}
+	var result []*User
+	for {
+		users, resp, err := t.GetUsers(listOpts)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, users...)
+		if !hasNextPage(resp, listOpts.Page, listOpts.PerPage, len(users)) {
+			break
+		}
+		listOpts.Page++
+	}
+
+	return result, nil
+}
+
+// GetUser
+// Get user by login or uid
+func (t *TrackerClient) GetUser(loginOrUID string) (*User, *resty.Response, error) {
+	req := t.NewRequest(resty.MethodGet, "/v2/users/"+loginOrUID, nil)
+	result := new(User)
+	resp, err := t.Do(req, result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+// ResolveUser
+// Get user by login or uid, caching the result for the lifetime of the client.
+// Useful to translate logins into uids for fields that require them.
+func (t *TrackerClient) ResolveUser(loginOrID string) (*User, error) {
+	t.usersMu.Lock()
+	user, ok := t.users[loginOrID]
+	t.usersMu.Unlock()
+	if ok {
+		return user, nil
+	}
+
+	user, _, err := t.GetUser(loginOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	t.usersMu.Lock()
+	defer t.usersMu.Unlock()
+	if t.users == nil {
+		t.users = make(map[string]*User)
+	}
+	t.users[loginOrID] = user
+	t.users[user.Login] = user
+	t.users[strconv.Itoa(user.UID)] = user
+
+	return user, nil
+}
+
+// GetVoters
+// Get the users who voted for the issue
+func (t *TrackerClient) GetVoters(issueKey string) ([]*BasicUser, error) {
+	issue, _, err := t.GetIssueFields(issueKey, []string{"votes", "votedBy"})
+	if err != nil {
+		return nil, err
+	}
+
+	return issue.VotedBy, nil
+}
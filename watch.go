@@ -0,0 +1,30 @@
+package tracker
+
+import (
+	"fmt"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// WatchIssue
+// Add the current user to the issue followers
+func (t *TrackerClient) WatchIssue(issueKey string) (*Issue, *resty.Response, error) {
+	return t.changeOwnFollowing(issueKey, "add")
+}
+
+// UnwatchIssue
+// Remove the current user from the issue followers
+func (t *TrackerClient) UnwatchIssue(issueKey string) (*Issue, *resty.Response, error) {
+	return t.changeOwnFollowing(issueKey, "remove")
+}
+
+func (t *TrackerClient) changeOwnFollowing(issueKey, operation string) (*Issue, *resty.Response, error) {
+	me, err := t.Myself()
+	if err != nil {
+		return nil, nil, fmt.Errorf("myself: %w", err)
+	}
+
+	return t.UpdateIssueRaw(issueKey, map[string]interface{}{
+		"followers": map[string]interface{}{operation: []string{me.Login}},
+	})
+}
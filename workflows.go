@@ -0,0 +1,161 @@
+package tracker
+
+import (
+	"fmt"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Workflow structure in Yandex.Tracker
+type Workflow struct {
+	// Address of the API resource with information about the workflow.
+	Self string `json:"self"`
+
+	// Workflow ID.
+	ID string `json:"id"`
+
+	// Workflow name.
+	Name string `json:"name"`
+
+	// Array of objects with information about the workflow statuses and transitions out of them.
+	Steps []*WorkflowStep `json:"steps"`
+
+	// Array of objects with information about the queue issue types using the workflow.
+	IssueTypes []*IssueType `json:"-"`
+}
+
+type WorkflowStep struct {
+	// Object with information about the status.
+	Status *BasicStatus `json:"status"`
+
+	// Array of objects with information about the transitions allowed from the status.
+	Transitions []*Transition `json:"actions"`
+}
+
+// Transitions
+// Get the transitions allowed from every status of the workflow keyed by the status key
+func (w *Workflow) Transitions() map[string][]*Transition {
+	result := make(map[string][]*Transition, len(w.Steps))
+	for _, step := range w.Steps {
+		if step.Status != nil {
+			result[step.Status.Key] = step.Transitions
+		}
+	}
+
+	return result
+}
+
+// GetQueueWorkflows
+// Get the workflows of the queue with their statuses, transitions and the issue types using them
+func (t *TrackerClient) GetQueueWorkflows(queueKey string) ([]*Workflow, error) {
+	queue, _, err := t.GetQueue(queueKey, "workflows")
+	if err != nil {
+		return nil, err
+	}
+
+	req := t.NewRequest(resty.MethodGet, "/v2/queues/"+t.CanonicalQueueKey(queueKey)+"/workflows", nil)
+	var result []*Workflow
+	if _, err := t.Do(req, &result); err != nil {
+		return nil, fmt.Errorf("request: %w", err)
+	}
+	for _, workflow := range result {
+		workflow.IssueTypes = queue.Workflows[workflow.ID]
+	}
+
+	return result, nil
+}
+
+// TransitionTo
+// Move the issue to the target status, executing several transitions if there is no direct one.
+// The shortest path of statuses is found in the workflow the queue uses for the issue type,
+// then each step is executed with the transition the issue offers to the next status.
+// Returns an error wrapping ErrNotFound if the target status cannot be reached.
+func (t *TrackerClient) TransitionTo(issueKey, targetStatusKey string) (*Issue, error) {
+	issue, _, err := t.GetIssue(issueKey)
+	if err != nil {
+		return nil, err
+	}
+	if issue.Status == nil || issue.Queue == nil {
+		return nil, fmt.Errorf("issue %s has no status or queue", issueKey)
+	}
+	if issue.Status.Key == targetStatusKey {
+		return issue, nil
+	}
+
+	workflows, err := t.GetQueueWorkflows(issue.Queue.Key)
+	if err != nil {
+		return nil, err
+	}
+	workflow := workflowForType(workflows, issue.Type)
+	if workflow == nil {
+		return nil, fmt.Errorf("workflow of issue %s: %w", issueKey, ErrNotFound)
+	}
+	path := statusPath(workflow, issue.Status.Key, targetStatusKey)
+	if path == nil {
+		return nil, fmt.Errorf("path from status %s to %s: %w", issue.Status.Key, targetStatusKey, ErrNotFound)
+	}
+
+	for _, statusKey := range path {
+		transition, err := t.FindTransitionTo(issueKey, statusKey)
+		if err != nil {
+			return nil, err
+		}
+		if _, _, err := t.ExecuteTransition(issueKey, transition.ID, nil); err != nil {
+			return nil, fmt.Errorf("transition %s: %w", transition.ID, err)
+		}
+	}
+	issue, _, err = t.GetIssue(issueKey)
+
+	return issue, err
+}
+
+// workflowForType
+// Get the workflow used for the issue type; a queue with a single workflow uses it for every type
+func workflowForType(workflows []*Workflow, issueType *IssueType) *Workflow {
+	if len(workflows) == 1 {
+		return workflows[0]
+	}
+	if issueType == nil {
+		return nil
+	}
+	for _, workflow := range workflows {
+		for _, workflowType := range workflow.IssueTypes {
+			if workflowType.Key == issueType.Key {
+				return workflow
+			}
+		}
+	}
+
+	return nil
+}
+
+// statusPath
+// Find the shortest sequence of statuses leading from one status to another with breadth-first search.
+// The result excludes the starting status and is nil if the target cannot be reached
+func statusPath(workflow *Workflow, from, to string) []string {
+	transitions := workflow.Transitions()
+	previous := map[string]string{from: ""}
+	queue := []string{from}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if current == to {
+			var path []string
+			for status := to; status != from; status = previous[status] {
+				path = append([]string{status}, path...)
+			}
+			return path
+		}
+		for _, transition := range transitions[current] {
+			if transition.To == nil {
+				continue
+			}
+			if _, seen := previous[transition.To.Key]; !seen {
+				previous[transition.To.Key] = current
+				queue = append(queue, transition.To.Key)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package tracker
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Worklog structure in Yandex.Tracker
+// https://cloud.yandex.ru/en/docs/tracker/concepts/issues/get-worklog
+type Worklog struct {
+	// Address of the API resource with information about the record.
+	Self string `json:"self"`
+
+	// Record ID.
+	ID int64 `json:"id"`
+
+	// Record version. Each change to the record increases its version number.
+	Version int `json:"version"`
+
+	// Object with information about the issue.
+	Issue *BasicIssue `json:"issue"`
+
+	// Comment to the record.
+	Comment string `json:"comment"`
+
+	// Object with information about the user who added the record.
+	CreatedBy *BasicUser `json:"createdBy"`
+
+	// Object with information about the user who edited the record last.
+	UpdatedBy *BasicUser `json:"updatedBy"`
+
+	// Record creation date and time.
+	CreatedAt TrackerTime `json:"createdAt"`
+
+	// Date and time when the record was last updated.
+	UpdatedAt TrackerTime `json:"updatedAt"`
+
+	// Date and time when work on the issue started.
+	Start TrackerTime `json:"start"`
+
+	// Time spent.
+	Duration *TrackerDuration `json:"duration"`
+}
+
+func (t *TrackerClient) GetWorklogs(issueKey string) ([]*Worklog, *resty.Response, error) {
+	req := t.NewRequest(resty.MethodGet, "/v2/issues/"+issueKey+"/worklog", nil)
+	var result []*Worklog
+	resp, err := t.Do(req, &result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+// https://cloud.yandex.ru/en/docs/tracker/concepts/issues/new-worklog
+type AddWorklogOptions struct {
+	// Date and time when work on the issue started. Required.
+	Start TrackerTime `json:"start"`
+
+	// Time spent, sent in ISO 8601 format. Required.
+	Duration TrackerDuration `json:"duration"`
+
+	// Comment to the record.
+	Comment *string `json:"comment,omitempty"`
+}
+
+func (t *TrackerClient) AddWorklog(issueKey string, opts *AddWorklogOptions) (*Worklog, *resty.Response, error) {
+	switch {
+	case opts == nil || opts.Start.IsZero():
+		return nil, nil, &ValidationError{Field: "start", Reason: "is required to add a worklog record"}
+	case opts.Duration.Duration <= 0:
+		return nil, nil, &ValidationError{Field: "duration", Reason: "must be positive"}
+	}
+
+	req := t.NewRequest(resty.MethodPost, "/v2/issues/"+issueKey+"/worklog", opts)
+	result := new(Worklog)
+	resp, err := t.Do(req, result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+// LogTime
+// Add a worklog record of work started now. The duration is either ISO 8601, e.g. PT1H30M,
+// or Go syntax, e.g. 1h30m; the comment is left out if empty.
+func (t *TrackerClient) LogTime(issueKey, duration, comment string) (*Worklog, error) {
+	d, err := parseLogDuration(duration)
+	if err != nil {
+		return nil, &ValidationError{Field: "duration", Reason: err.Error()}
+	}
+
+	opts := &AddWorklogOptions{Start: TrackerTime{Time: time.Now()}, Duration: TrackerDuration{Duration: d}}
+	if comment != "" {
+		opts.Comment = &comment
+	}
+	worklog, _, err := t.AddWorklog(issueKey, opts)
+
+	return worklog, err
+}
+
+func parseLogDuration(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(strings.ToUpper(s), "P") {
+		return ParseTrackerDuration(strings.ToUpper(s))
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("%q is neither an ISO 8601 nor a Go duration", s)
+	}
+
+	return d, nil
+}
+
+// SetEstimation
+// Set the remaining time estimate of the issue, sent in ISO 8601 format
+func (t *TrackerClient) SetEstimation(issueKey string, d time.Duration) (*Issue, error) {
+	issue, _, err := t.UpdateIssue(issueKey, &UpdateIssueOptions{
+		Fields: map[string]interface{}{"estimation": TrackerDuration{Duration: d}},
+	})
+
+	return issue, err
+}
+
+// SetSpent
+// Overwrite the time spent on the issue, sent in ISO 8601 format.
+// Unlike a worklog record it keeps no author or date of the work and is replaced as a whole.
+func (t *TrackerClient) SetSpent(issueKey string, d time.Duration) (*Issue, error) {
+	issue, _, err := t.UpdateIssue(issueKey, &UpdateIssueOptions{
+		Fields: map[string]interface{}{"spent": TrackerDuration{Duration: d}},
+	})
+
+	return issue, err
+}
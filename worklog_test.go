@@ -0,0 +1,79 @@
+package tracker
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestParseLogDuration(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Duration
+	}{
+		{in: "1h30m", want: 90 * time.Minute},
+		{in: " 45m ", want: 45 * time.Minute},
+		{in: "PT1H30M", want: 90 * time.Minute},
+		{in: "pt2h", want: 2 * time.Hour},
+		{in: "P1D", want: 8 * time.Hour},
+		{in: "P1W", want: 40 * time.Hour},
+		{in: "PT1,5H", want: 90 * time.Minute},
+		{in: "P1DT0.5H", want: 8*time.Hour + 30*time.Minute},
+	}
+	for _, tt := range tests {
+		got, err := parseLogDuration(tt.in)
+		if err != nil {
+			t.Errorf("parseLogDuration(%q): %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseLogDuration(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+
+	for _, in := range []string{
+		"", "1.5 hours", "P", "PT1X", "PT", "P1DT", "PTT", "PT1HT",
+		"PTNaNH", "PTInfH", "PT1e2H", "PT-1H", "PT+1H", "PT.5H", "PT1.H", "PT1.5.5H",
+	} {
+		if _, err := parseLogDuration(in); err == nil {
+			t.Errorf("parseLogDuration(%q) succeeded", in)
+		}
+	}
+}
+
+func TestLogTime(t *testing.T) {
+	var body map[string]interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v2/issues/TEST-1/worklog" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decode body: %v", err)
+		}
+		_, _ = w.Write([]byte(`{"id":5,"duration":"PT1H30M"}`))
+	})
+
+	before := time.Now().Add(-time.Second)
+	worklog, err := client.LogTime("TEST-1", "1h30m", "review")
+	if err != nil {
+		t.Fatalf("LogTime: %v", err)
+	}
+	if worklog.ID != 5 {
+		t.Errorf("worklog = %+v", worklog)
+	}
+	if body["duration"] != "PT1H30M" || body["comment"] != "review" {
+		t.Errorf("body = %v", body)
+	}
+	var start TrackerTime
+	data, _ := json.Marshal(body["start"])
+	if err := json.Unmarshal(data, &start); err != nil || start.Before(before) || start.After(time.Now()) {
+		t.Errorf("start = %v, want now", body["start"])
+	}
+
+	var validationErr *ValidationError
+	if _, err := client.LogTime("TEST-1", "soon", ""); !errors.As(err, &validationErr) {
+		t.Errorf("err = %v, want ValidationError", err)
+	}
+}